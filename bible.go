@@ -0,0 +1,403 @@
+package orthocal
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Bibles
+//
+// A MemoryBible holds a translation loaded from USFM or OSIS files so that
+// scripture can be included in the readings without an external Bible.
+
+var ErrInvalidBible = errors.New("orthocal: invalid bible")
+
+// MemoryBible is a Bible that holds a translation in memory. It must not be
+// loaded while it is being used, but it is otherwise safe for concurrent use.
+type MemoryBible struct {
+	versification Versification
+	books         map[string]map[int][]Verse // verses by book and chapter
+}
+
+// NewMemoryBible returns an empty MemoryBible for a translation with the
+// given versification.
+func NewMemoryBible(versification Versification) *MemoryBible {
+	return &MemoryBible{
+		versification: versification,
+		books:         make(map[string]map[int][]Verse),
+	}
+}
+
+// LoadBibleFiles returns a MemoryBible loaded from the given files. USFM files
+// end in .usfm, .sfm or .ptx and OSIS files in .xml or .osis. The files with
+// those extensions in a directory are loaded.
+func LoadBibleFiles(versification Versification, paths ...string) (*MemoryBible, error) {
+	bible := NewMemoryBible(versification)
+
+	for _, path := range paths {
+		info, e := os.Stat(path)
+		if e != nil {
+			return nil, e
+		}
+
+		files := []string{path}
+		if info.IsDir() {
+			entries, e := os.ReadDir(path)
+			if e != nil {
+				return nil, e
+			}
+
+			files = nil
+			for _, entry := range entries {
+				if !entry.IsDir() && bibleFileLoader(bible, entry.Name()) != nil {
+					files = append(files, filepath.Join(path, entry.Name()))
+				}
+			}
+		}
+
+		for _, file := range files {
+			if e := bible.loadFile(file); e != nil {
+				return nil, e
+			}
+		}
+	}
+
+	return bible, nil
+}
+
+// Returns the method that loads the file or nil if it is not a Bible.
+func bibleFileLoader(bible *MemoryBible, name string) func(io.Reader) error {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".usfm", ".sfm", ".ptx":
+		return bible.LoadUSFM
+	case ".xml", ".osis":
+		return bible.LoadOSIS
+	}
+
+	return nil
+}
+
+func (self *MemoryBible) loadFile(path string) error {
+	load := bibleFileLoader(self, path)
+	if load == nil {
+		return fmt.Errorf("%w: unknown format of %s", ErrInvalidBible, path)
+	}
+
+	f, e := os.Open(path)
+	if e != nil {
+		return e
+	}
+	defer f.Close()
+
+	if e := load(f); e != nil {
+		return fmt.Errorf("%w (%s)", e, path)
+	}
+
+	return nil
+}
+
+// The USFM markers of lines that are not part of the text
+var usfmSkippedMarkers = map[string]bool{
+	"h": true, "toc1": true, "toc2": true, "toc3": true, "mt": true, "mt1": true,
+	"mt2": true, "mt3": true, "ms": true, "ms1": true, "ms2": true, "mr": true,
+	"s": true, "s1": true, "s2": true, "s3": true, "sr": true, "r": true,
+	"d": true, "cl": true, "cp": true, "rem": true, "sts": true, "ide": true,
+	"usfm": true, "imt": true, "imt1": true, "is": true, "is1": true,
+	"ip": true, "ipr": true, "iot": true, "io": true, "io1": true, "ie": true,
+}
+
+var (
+	usfmLineRe    = regexp.MustCompile(`^\\([a-z]+[0-9]*)\s*(.*)$`)
+	usfmNoteRe    = regexp.MustCompile(`(?s)\\(f|fe|x|ef|ex)\s.*?\\(f|fe|x|ef|ex)\*`)
+	usfmWordRe    = regexp.MustCompile(`\|[^\\|]*(\\\+?w\*)`)
+	usfmVerseRe   = regexp.MustCompile(`\\v\s+(\d+)\S*\s*`)
+	usfmClosingRe = regexp.MustCompile(`\\\+?[a-z]+[0-9]*\*`)
+	usfmOpeningRe = regexp.MustCompile(`\\\+?[a-z]+[0-9]* ?`)
+)
+
+// LoadUSFM loads a book of the Bible from a USFM file.
+func (self *MemoryBible) LoadUSFM(r io.Reader) error {
+	data, e := io.ReadAll(r)
+	if e != nil {
+		return e
+	}
+
+	text := usfmNoteRe.ReplaceAllLiteralString(string(data), "")
+	text = usfmWordRe.ReplaceAllString(text, "$1")
+
+	var book string
+	var chapter int
+	var verse *Verse
+	var verses []Verse
+
+	flush := func() {
+		if verse != nil {
+			verse.Content = cleanVerse(verse.Content)
+			verses = append(verses, *verse)
+			verse = nil
+		}
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		if groups := usfmLineRe.FindStringSubmatch(line); groups != nil {
+			marker, rest := groups[1], groups[2]
+
+			switch {
+			case marker == "id":
+				fields := strings.Fields(rest)
+				if len(fields) == 0 {
+					return fmt.Errorf("%w: \\id without a book", ErrInvalidBible)
+				}
+				book = strings.ToUpper(fields[0])
+				continue
+			case marker == "c":
+				flush()
+				n, e := strconv.Atoi(strings.Fields(rest + " ")[0])
+				if e != nil {
+					return fmt.Errorf("%w: invalid chapter %#v", ErrInvalidBible, rest)
+				}
+				chapter = n
+				continue
+			case usfmSkippedMarkers[marker]:
+				continue
+			}
+		}
+
+		if len(book) == 0 {
+			return fmt.Errorf("%w: text before \\id", ErrInvalidBible)
+		}
+
+		// Text before the first verse marker continues the previous verse
+		locations := usfmVerseRe.FindAllStringSubmatchIndex(line, -1)
+		end := len(line)
+		if len(locations) > 0 {
+			end = locations[0][0]
+		}
+		if verse != nil {
+			verse.Content += " " + line[:end]
+		}
+
+		for i, location := range locations {
+			flush()
+
+			n, _ := strconv.Atoi(line[location[2]:location[3]])
+			verse = &Verse{Book: book, Chapter: uint16(chapter), Verse: uint16(n)}
+
+			end := len(line)
+			if i+1 < len(locations) {
+				end = locations[i+1][0]
+			}
+			verse.Content = line[location[1]:end]
+		}
+	}
+	flush()
+
+	self.addVerses(verses)
+	return nil
+}
+
+// OSIS elements whose text is not part of the verses
+var osisSkippedElements = map[string]bool{
+	"note": true, "title": true, "header": true,
+}
+
+// OSIS elements that separate words, unlike inline elements such as w
+var osisBlockElements = map[string]bool{
+	"p": true, "l": true, "lg": true, "lb": true, "div": true, "chapter": true,
+	"q": true, "list": true, "item": true, "milestone": true,
+}
+
+// LoadOSIS loads books of the Bible from an OSIS XML file. Verses may be
+// containers or milestones.
+func (self *MemoryBible) LoadOSIS(r io.Reader) error {
+	decoder := xml.NewDecoder(r)
+
+	var verses []Verse
+	var verse *Verse
+	var text strings.Builder
+	container, skipped := false, 0
+
+	flush := func() {
+		if verse != nil {
+			verse.Content = cleanVerse(text.String())
+			verses = append(verses, *verse)
+			verse = nil
+		}
+		text.Reset()
+	}
+
+	for {
+		token, e := decoder.Token()
+		if e == io.EOF {
+			break
+		} else if e != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidBible, e)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skipped > 0 || osisSkippedElements[t.Name.Local] {
+				skipped++
+				continue
+			}
+
+			if t.Name.Local != "verse" {
+				if osisBlockElements[t.Name.Local] {
+					text.WriteString(" ")
+				}
+				continue
+			}
+
+			if len(osisAttr(t, "eID")) > 0 {
+				flush()
+				continue
+			}
+
+			flush()
+			id := osisAttr(t, "sID")
+			container = len(id) == 0
+			if container {
+				id = osisAttr(t, "osisID")
+			}
+
+			if verse, e = parseOSISID(id); e != nil {
+				return e
+			}
+
+		case xml.EndElement:
+			if skipped > 0 {
+				skipped--
+				continue
+			}
+
+			if t.Name.Local == "verse" && container {
+				flush()
+				container = false
+			} else if osisBlockElements[t.Name.Local] {
+				text.WriteString(" ")
+			}
+
+		case xml.CharData:
+			if skipped == 0 && verse != nil {
+				text.Write(t)
+			}
+		}
+	}
+	flush()
+
+	self.addVerses(verses)
+	return nil
+}
+
+func osisAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+// Parse an OSIS identifier such as Gen.1.1. Only the first verse of a list is
+// used.
+func parseOSISID(id string) (*Verse, error) {
+	fields := strings.Split(strings.Fields(id + " ")[0], ".")
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("%w: invalid verse %#v", ErrInvalidBible, id)
+	}
+
+	book, ok := osisBooks[fields[0]]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown book %#v", ErrInvalidBible, fields[0])
+	}
+
+	chapter, e1 := strconv.Atoi(fields[1])
+	verse, e2 := strconv.Atoi(fields[2])
+	if e1 != nil || e2 != nil {
+		return nil, fmt.Errorf("%w: invalid verse %#v", ErrInvalidBible, id)
+	}
+
+	return &Verse{Book: book, Chapter: uint16(chapter), Verse: uint16(verse)}, nil
+}
+
+// Remove the remaining USFM markers and extra space from the text of a verse.
+func cleanVerse(text string) string {
+	text = usfmClosingRe.ReplaceAllLiteralString(text, "")
+	text = usfmOpeningRe.ReplaceAllLiteralString(text, "")
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func (self *MemoryBible) addVerses(verses []Verse) {
+	for _, v := range verses {
+		chapters, ok := self.books[v.Book]
+		if !ok {
+			chapters = make(map[int][]Verse)
+			self.books[v.Book] = chapters
+		}
+		chapters[int(v.Chapter)] = append(chapters[int(v.Chapter)], v)
+	}
+
+	for _, chapters := range self.books {
+		for _, verses := range chapters {
+			sort.SliceStable(verses, func(i, j int) bool {
+				return verses[i].Verse < verses[j].Verse
+			})
+		}
+	}
+}
+
+// Versification returns the versification of the translation.
+func (self *MemoryBible) Versification() Versification {
+	return self.versification
+}
+
+// Lookup is like LookupWithContext with a background context.
+func (self *MemoryBible) Lookup(reference string) Passage {
+	return self.LookupWithContext(context.Background(), reference)
+}
+
+// LookupWithContext returns the verses of a reference in the style of
+// ParseReference or nil if the reference cannot be parsed. References must
+// already be in the versification of the translation. Half-verses are
+// returned whole.
+func (self *MemoryBible) LookupWithContext(ctx context.Context, reference string) Passage {
+	references, e := ParseReference(reference)
+	if e != nil {
+		return nil
+	}
+
+	var passage Passage
+	for _, r := range references {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		chapters := self.books[r.Book]
+		for chapter := r.StartChapter; chapter <= r.EndChapter; chapter++ {
+			for _, v := range chapters[chapter] {
+				verse := int(v.Verse)
+				if r.StartVerse != 0 && ((chapter == r.StartChapter && verse < r.StartVerse) || (chapter == r.EndChapter && verse > r.EndVerse)) {
+					continue
+				}
+				passage = append(passage, v)
+			}
+		}
+	}
+
+	return passage
+}
@@ -0,0 +1,112 @@
+package orthocal_test
+
+import (
+	"errors"
+	"github.com/brianglass/orthocal"
+	"strings"
+	"testing"
+)
+
+func TestMemoryBible(t *testing.T) {
+	bible, e := orthocal.LoadBibleFiles(orthocal.VersificationMT, "testdata/kjv")
+	if e != nil {
+		t.Fatalf("Got error loading bible: %#v.", e)
+	}
+
+	t.Run("Versification", func(t *testing.T) {
+		if bible.Versification() != orthocal.VersificationMT {
+			t.Errorf("The bible should follow the Masoretic text but follows %#v.", bible.Versification())
+		}
+	})
+
+	t.Run("USFM", func(t *testing.T) {
+		passage := bible.Lookup("Luke 24.1-12")
+		if len(passage) != 12 {
+			t.Fatalf("Luke 24.1-12 should be 12 verses long but is %d.", len(passage))
+		}
+
+		verse := passage[0]
+		if verse.Book != "LUK" || verse.Chapter != 24 || verse.Verse != 1 {
+			t.Errorf("The first verse should be Luke 24.1 but is %#v.", verse)
+		}
+		if !strings.HasPrefix(verse.Content, "Now upon the first day") || !strings.HasSuffix(verse.Content, "certain others with them.") {
+			t.Errorf("Luke 24.1 should not contain markers but is %#v.", verse.Content)
+		}
+
+		if content := passage[6].Content; strings.Contains(content, `\`) {
+			t.Errorf("Luke 24.7 should not contain markers but is %#v.", content)
+		}
+	})
+
+	t.Run("OSIS", func(t *testing.T) {
+		passage := bible.Lookup("Rom 13.11-14.4")
+		if len(passage) != 8 {
+			t.Fatalf("Rom 13.11-14.4 should be 8 verses long but is %d.", len(passage))
+		}
+
+		expected := "Let us walk honestly, as in the day; not in rioting and drunkenness, not in chambering and wantonness, not in strife and envying."
+		if content := passage[2].Content; content != expected {
+			t.Errorf("Romans 13.13 should be %#v but is %#v.", expected, content)
+		}
+
+		expected = "But put ye on the Lord Jesus Christ, and make not provision for the flesh, to fulfil the lusts thereof."
+		if content := passage[3].Content; content != expected {
+			t.Errorf("Romans 13.14 should be %#v but is %#v.", expected, content)
+		}
+	})
+
+	t.Run("References", func(t *testing.T) {
+		tests := map[string]int{
+			"Matt 6.14-21":          8,
+			"Matt 6.14-15, 19-21":   5,
+			"Matt 6":                8,
+			"Luke 24.12; Rom 14.1":  2,
+			"Rom 14.4-10":           1,
+			"John 3.16":             0,
+			"Not a reference 1.1-2": 0,
+		}
+
+		for reference, expected := range tests {
+			if actual := len(bible.Lookup(reference)); actual != expected {
+				t.Errorf("%#v should be %d verses long but is %d.", reference, expected, actual)
+			}
+		}
+	})
+
+	t.Run("Container", func(t *testing.T) {
+		osis := `<osis><osisText><div type="book" osisID="Jude">
+			<title>Jude</title>
+			<chapter osisID="Jude.1">
+				<verse osisID="Jude.1.1">Jude, the servant of Jesus Christ,<note>a note</note> and brother of James,</verse>
+				<verse osisID="Jude.1.2">Mercy unto you, and peace, and love, be multiplied.</verse>
+			</chapter>
+		</div></osisText></osis>`
+
+		bible := orthocal.NewMemoryBible(orthocal.VersificationLXX)
+		if e := bible.LoadOSIS(strings.NewReader(osis)); e != nil {
+			t.Fatalf("Got error loading OSIS: %#v.", e)
+		}
+
+		passage := bible.Lookup("Jude 1-2")
+		if len(passage) != 2 {
+			t.Fatalf("Jude 1-2 should be 2 verses long but is %d.", len(passage))
+		}
+		if expected := "Jude, the servant of Jesus Christ, and brother of James,"; passage[0].Content != expected {
+			t.Errorf("Jude 1 should be %#v but is %#v.", expected, passage[0].Content)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		bible := orthocal.NewMemoryBible(orthocal.VersificationMT)
+
+		if e := bible.LoadUSFM(strings.NewReader("\\c 1\n\\v 1 In the beginning")); !errors.Is(e, orthocal.ErrInvalidBible) {
+			t.Errorf("USFM without a book should fail with ErrInvalidBible but got %#v.", e)
+		}
+		if e := bible.LoadOSIS(strings.NewReader(`<osis><verse osisID="Foo.1.1">`)); !errors.Is(e, orthocal.ErrInvalidBible) {
+			t.Errorf("OSIS with an unknown book should fail with ErrInvalidBible but got %#v.", e)
+		}
+		if _, e := orthocal.LoadBibleFiles(orthocal.VersificationMT, "oca_calendar.db"); !errors.Is(e, orthocal.ErrInvalidBible) {
+			t.Errorf("Loading a file of unknown format should fail with ErrInvalidBible but got %#v.", e)
+		}
+	})
+}
@@ -72,6 +72,7 @@ var BookNames = map[string]string{
 	"zechariah":       "ZEC",
 	"hag":             "HAG",
 	"hagai":           "HAG",
+	"haggai":          "HAG",
 	"lam":             "LAM",
 	"lamentations":    "LAM",
 	"ezek":            "EZK",
@@ -108,6 +109,7 @@ var BookNames = map[string]string{
 	"2 esdras":               "2ES",
 	"manasseh":               "MAN",
 	"the prayer of manasseh": "MAN",
+	"prayer of manasseh":     "MAN",
 
 	// New Testament
 	"matt":            "MAT",
@@ -121,6 +123,7 @@ var BookNames = map[string]string{
 	"jn":              "JHN",
 	"acts":            "ACT",
 	"rom":             "ROM",
+	"romans":          "ROM",
 	"1 cor":           "1CO",
 	"1 corinthians":   "1CO",
 	"2 cor":           "2CO",
@@ -137,6 +140,7 @@ var BookNames = map[string]string{
 	"philippians":     "PHP",
 	"col":             "COL",
 	"colosians":       "COL",
+	"colossians":      "COL",
 	"1 tim":           "1TI",
 	"1 timothy":       "1TI",
 	"2 tim":           "2TI",
@@ -170,7 +174,217 @@ func NormalizeBookName(name string) string {
 	name = strings.ToLower(name)
 	if normalized, ok := BookNames[name]; ok {
 		return normalized
+	} else if normalized, ok := registryNames[name]; ok {
+		return normalized
 	} else {
 		return ""
 	}
 }
+
+// Book registry
+
+// A Testament is the Old or New Testament.
+type Testament int
+
+const (
+	OldTestament Testament = iota
+	NewTestament
+)
+
+// A BookNameStyle is a convention for naming the books of the Bible.
+type BookNameStyle int
+
+const (
+	LectionaryNames BookNameStyle = iota // as in the pericopes, e.g. 3 [1] Kings
+	OrthodoxNames                        // as in English Septuagints, e.g. 3 Kingdoms
+	ProtestantNames                      // as in most English Bibles, e.g. 1 Kings
+)
+
+// A Book is a book of the Bible. Chapters are counted as in the lectionary,
+// so the Psalms have 151 and Daniel has 14.
+type Book struct {
+	Code             string // USFM
+	Order            int    // canonical order, starting at 1
+	Testament        Testament
+	Deuterocanonical bool
+	Chapters         int
+
+	names         [3]string // by BookNameStyle
+	abbreviations [3]string
+}
+
+// Name returns the name of the book in the given style.
+func (self Book) Name(style BookNameStyle) string {
+	return self.names[style]
+}
+
+// Abbreviation returns the abbreviated name of the book in the given style.
+func (self Book) Abbreviation(style BookNameStyle) string {
+	return self.abbreviations[style]
+}
+
+// The books in the order of the Septuagint, with the New Testament after
+var books = []Book{
+	// Old Testament
+	{"GEN", 0, OldTestament, false, 50, [3]string{"Genesis", "Genesis", "Genesis"}, [3]string{"Gen", "Gen", "Gen"}},
+	{"EXO", 0, OldTestament, false, 40, [3]string{"Exodus", "Exodus", "Exodus"}, [3]string{"Exod", "Ex", "Exod"}},
+	{"LEV", 0, OldTestament, false, 27, [3]string{"Leviticus", "Leviticus", "Leviticus"}, [3]string{"Lev", "Lev", "Lev"}},
+	{"NUM", 0, OldTestament, false, 36, [3]string{"Numbers", "Numbers", "Numbers"}, [3]string{"Num", "Num", "Num"}},
+	{"DEU", 0, OldTestament, false, 34, [3]string{"Deuteronomy", "Deuteronomy", "Deuteronomy"}, [3]string{"Deut", "Deut", "Deut"}},
+	{"JOS", 0, OldTestament, false, 24, [3]string{"Joshua", "Joshua", "Joshua"}, [3]string{"Josh", "Josh", "Josh"}},
+	{"JDG", 0, OldTestament, false, 21, [3]string{"Judges", "Judges", "Judges"}, [3]string{"Judges", "Judg", "Judg"}},
+	{"RUT", 0, OldTestament, false, 4, [3]string{"Ruth", "Ruth", "Ruth"}, [3]string{"Ruth", "Ruth", "Ruth"}},
+	{"1SA", 0, OldTestament, false, 31, [3]string{"1 Kings", "1 Kingdoms", "1 Samuel"}, [3]string{"1 Kgs", "1 Kgdms", "1 Sam"}},
+	{"2SA", 0, OldTestament, false, 24, [3]string{"2 Kings", "2 Kingdoms", "2 Samuel"}, [3]string{"2 Kgs", "2 Kgdms", "2 Sam"}},
+	{"1KI", 0, OldTestament, false, 22, [3]string{"3 [1] Kings", "3 Kingdoms", "1 Kings"}, [3]string{"3 Kgs", "3 Kgdms", "1 Kgs"}},
+	{"2KI", 0, OldTestament, false, 25, [3]string{"4 [2] Kings", "4 Kingdoms", "2 Kings"}, [3]string{"4 Kgs", "4 Kgdms", "2 Kgs"}},
+	{"1CH", 0, OldTestament, false, 29, [3]string{"1 Chronicles", "1 Chronicles", "1 Chronicles"}, [3]string{"1 Chr", "1 Chr", "1 Chr"}},
+	{"2CH", 0, OldTestament, false, 36, [3]string{"2 Chronicles", "2 Chronicles", "2 Chronicles"}, [3]string{"2 Chr", "2 Chr", "2 Chr"}},
+	{"1ES", 0, OldTestament, true, 9, [3]string{"1 Esdras", "1 Ezra", "1 Esdras"}, [3]string{"1 Esdras", "1 Ezra", "1 Esd"}},
+	{"EZR", 0, OldTestament, false, 10, [3]string{"Ezra", "2 Ezra", "Ezra"}, [3]string{"Ezra", "2 Ezra", "Ezra"}},
+	{"NEH", 0, OldTestament, false, 13, [3]string{"Nehemiah", "Nehemiah", "Nehemiah"}, [3]string{"Neh", "Neh", "Neh"}},
+	{"TOB", 0, OldTestament, true, 14, [3]string{"Tobit", "Tobit", "Tobit"}, [3]string{"Tob", "Tob", "Tob"}},
+	{"JDT", 0, OldTestament, true, 16, [3]string{"Judith", "Judith", "Judith"}, [3]string{"Judith", "Jdt", "Jdt"}},
+	{"EST", 0, OldTestament, false, 16, [3]string{"Esther", "Esther", "Esther"}, [3]string{"Esth", "Esth", "Esth"}},
+	{"ESG", 0, OldTestament, true, 16, [3]string{"Additions to Esther", "Additions to Esther", "Additions to Esther"}, [3]string{"Additions to Esther", "Add Esth", "Add Esth"}},
+	{"1MA", 0, OldTestament, true, 16, [3]string{"1 Maccabees", "1 Maccabees", "1 Maccabees"}, [3]string{"1 Maccabees", "1 Macc", "1 Macc"}},
+	{"2MA", 0, OldTestament, true, 15, [3]string{"2 Maccabees", "2 Maccabees", "2 Maccabees"}, [3]string{"2 Maccabees", "2 Macc", "2 Macc"}},
+	{"3MA", 0, OldTestament, true, 7, [3]string{"3 Maccabees", "3 Maccabees", "3 Maccabees"}, [3]string{"3 Maccabees", "3 Macc", "3 Macc"}},
+	{"PSA", 0, OldTestament, false, 151, [3]string{"Psalms", "Psalms", "Psalms"}, [3]string{"Ps", "Ps", "Ps"}},
+	{"JOB", 0, OldTestament, false, 42, [3]string{"Job", "Job", "Job"}, [3]string{"Job", "Job", "Job"}},
+	{"PRO", 0, OldTestament, false, 31, [3]string{"Proverbs", "Proverbs", "Proverbs"}, [3]string{"Prov", "Prov", "Prov"}},
+	{"ECC", 0, OldTestament, false, 12, [3]string{"Ecclesiastes", "Ecclesiastes", "Ecclesiastes"}, [3]string{"Eccl", "Eccl", "Eccl"}},
+	{"SNG", 0, OldTestament, false, 8, [3]string{"Song of Songs", "Song of Songs", "Song of Solomon"}, [3]string{"Song", "Song", "Song"}},
+	{"WIS", 0, OldTestament, true, 19, [3]string{"Wisdom of Solomon", "Wisdom of Solomon", "Wisdom of Solomon"}, [3]string{"Wis", "Wis", "Wis"}},
+	{"SIR", 0, OldTestament, true, 51, [3]string{"Sirach", "Wisdom of Sirach", "Sirach"}, [3]string{"Sirach", "Sir", "Sir"}},
+	{"HOS", 0, OldTestament, false, 14, [3]string{"Hosea", "Hosea", "Hosea"}, [3]string{"Hos", "Hos", "Hos"}},
+	{"AMO", 0, OldTestament, false, 9, [3]string{"Amos", "Amos", "Amos"}, [3]string{"Amos", "Amos", "Amos"}},
+	{"MIC", 0, OldTestament, false, 7, [3]string{"Micah", "Micah", "Micah"}, [3]string{"Micah", "Mic", "Mic"}},
+	{"JOL", 0, OldTestament, false, 4, [3]string{"Joel", "Joel", "Joel"}, [3]string{"Joel", "Joel", "Joel"}},
+	{"OBA", 0, OldTestament, false, 1, [3]string{"Obadiah", "Obadiah", "Obadiah"}, [3]string{"Obad", "Obad", "Obad"}},
+	{"JON", 0, OldTestament, false, 4, [3]string{"Jonah", "Jonah", "Jonah"}, [3]string{"Jonah", "Jon", "Jon"}},
+	{"NAM", 0, OldTestament, false, 3, [3]string{"Nahum", "Nahum", "Nahum"}, [3]string{"Nah", "Nah", "Nah"}},
+	{"HAB", 0, OldTestament, false, 3, [3]string{"Habakkuk", "Habakkuk", "Habakkuk"}, [3]string{"Hab", "Hab", "Hab"}},
+	{"ZEP", 0, OldTestament, false, 3, [3]string{"Zephaniah", "Zephaniah", "Zephaniah"}, [3]string{"Zeph", "Zeph", "Zeph"}},
+	{"HAG", 0, OldTestament, false, 2, [3]string{"Haggai", "Haggai", "Haggai"}, [3]string{"Hag", "Hag", "Hag"}},
+	{"ZEC", 0, OldTestament, false, 14, [3]string{"Zechariah", "Zechariah", "Zechariah"}, [3]string{"Zech", "Zech", "Zech"}},
+	{"MAL", 0, OldTestament, false, 4, [3]string{"Malachi", "Malachi", "Malachi"}, [3]string{"Malachi", "Mal", "Mal"}},
+	{"ISA", 0, OldTestament, false, 66, [3]string{"Isaiah", "Isaiah", "Isaiah"}, [3]string{"Isa", "Is", "Isa"}},
+	{"JER", 0, OldTestament, false, 52, [3]string{"Jeremiah", "Jeremiah", "Jeremiah"}, [3]string{"Jer", "Jer", "Jer"}},
+	{"BAR", 0, OldTestament, true, 6, [3]string{"Baruch", "Baruch", "Baruch"}, [3]string{"Baruch", "Bar", "Bar"}},
+	{"LAM", 0, OldTestament, false, 5, [3]string{"Lamentations", "Lamentations", "Lamentations"}, [3]string{"Lam", "Lam", "Lam"}},
+	{"LJE", 0, OldTestament, true, 1, [3]string{"Letter of Jeremiah", "Epistle of Jeremiah", "Letter of Jeremiah"}, [3]string{"Letter of Jeremiah", "Ep Jer", "Let Jer"}},
+	{"EZK", 0, OldTestament, false, 48, [3]string{"Ezekiel", "Ezekiel", "Ezekiel"}, [3]string{"Ezek", "Ezek", "Ezek"}},
+	{"DAN", 0, OldTestament, false, 14, [3]string{"Daniel", "Daniel", "Daniel"}, [3]string{"Daniel", "Dan", "Dan"}},
+	{"S3Y", 0, OldTestament, true, 1, [3]string{"Song of the Three", "Song of the Three Youths", "Prayer of Azariah"}, [3]string{"Song of the Three", "Song of Three", "Pr Azar"}},
+	{"SUS", 0, OldTestament, true, 1, [3]string{"Susanna", "Susanna", "Susanna"}, [3]string{"Susanna", "Sus", "Sus"}},
+	{"BEL", 0, OldTestament, true, 1, [3]string{"Bel and the Dragon", "Bel and the Dragon", "Bel and the Dragon"}, [3]string{"Bel and the Dragon", "Bel", "Bel"}},
+	{"4MA", 0, OldTestament, true, 18, [3]string{"4 Maccabees", "4 Maccabees", "4 Maccabees"}, [3]string{"4 Maccabees", "4 Macc", "4 Macc"}},
+	{"MAN", 0, OldTestament, true, 1, [3]string{"Prayer of Manasseh", "Prayer of Manasseh", "Prayer of Manasseh"}, [3]string{"Manasseh", "Pr Man", "Pr Man"}},
+	{"2ES", 0, OldTestament, true, 16, [3]string{"2 Esdras", "3 Ezra", "2 Esdras"}, [3]string{"2 Esdras", "3 Ezra", "2 Esd"}},
+
+	// New Testament
+	{"MAT", 0, NewTestament, false, 28, [3]string{"Matthew", "Matthew", "Matthew"}, [3]string{"Matt", "Matt", "Matt"}},
+	{"MRK", 0, NewTestament, false, 16, [3]string{"Mark", "Mark", "Mark"}, [3]string{"Mark", "Mark", "Mark"}},
+	{"LUK", 0, NewTestament, false, 24, [3]string{"Luke", "Luke", "Luke"}, [3]string{"Luke", "Luke", "Luke"}},
+	{"JHN", 0, NewTestament, false, 21, [3]string{"John", "John", "John"}, [3]string{"John", "John", "John"}},
+	{"ACT", 0, NewTestament, false, 28, [3]string{"Acts", "Acts", "Acts"}, [3]string{"Acts", "Acts", "Acts"}},
+	{"ROM", 0, NewTestament, false, 16, [3]string{"Romans", "Romans", "Romans"}, [3]string{"Rom", "Rom", "Rom"}},
+	{"1CO", 0, NewTestament, false, 16, [3]string{"1 Corinthians", "1 Corinthians", "1 Corinthians"}, [3]string{"1 Cor", "1 Cor", "1 Cor"}},
+	{"2CO", 0, NewTestament, false, 13, [3]string{"2 Corinthians", "2 Corinthians", "2 Corinthians"}, [3]string{"2 Cor", "2 Cor", "2 Cor"}},
+	{"GAL", 0, NewTestament, false, 6, [3]string{"Galatians", "Galatians", "Galatians"}, [3]string{"Gal", "Gal", "Gal"}},
+	{"EPH", 0, NewTestament, false, 6, [3]string{"Ephesians", "Ephesians", "Ephesians"}, [3]string{"Eph", "Eph", "Eph"}},
+	{"PHP", 0, NewTestament, false, 4, [3]string{"Philippians", "Philippians", "Philippians"}, [3]string{"Phil", "Phil", "Phil"}},
+	{"COL", 0, NewTestament, false, 4, [3]string{"Colossians", "Colossians", "Colossians"}, [3]string{"Col", "Col", "Col"}},
+	{"1TH", 0, NewTestament, false, 5, [3]string{"1 Thessalonians", "1 Thessalonians", "1 Thessalonians"}, [3]string{"1 Thess", "1 Thess", "1 Thess"}},
+	{"2TH", 0, NewTestament, false, 3, [3]string{"2 Thessalonians", "2 Thessalonians", "2 Thessalonians"}, [3]string{"2 Thess", "2 Thess", "2 Thess"}},
+	{"1TI", 0, NewTestament, false, 6, [3]string{"1 Timothy", "1 Timothy", "1 Timothy"}, [3]string{"1 Tim", "1 Tim", "1 Tim"}},
+	{"2TI", 0, NewTestament, false, 4, [3]string{"2 Timothy", "2 Timothy", "2 Timothy"}, [3]string{"2 Tim", "2 Tim", "2 Tim"}},
+	{"TIT", 0, NewTestament, false, 3, [3]string{"Titus", "Titus", "Titus"}, [3]string{"Titus", "Titus", "Titus"}},
+	{"PHM", 0, NewTestament, false, 1, [3]string{"Philemon", "Philemon", "Philemon"}, [3]string{"Philemon", "Philem", "Phlm"}},
+	{"HEB", 0, NewTestament, false, 13, [3]string{"Hebrews", "Hebrews", "Hebrews"}, [3]string{"Heb", "Heb", "Heb"}},
+	{"JAS", 0, NewTestament, false, 5, [3]string{"James", "James", "James"}, [3]string{"Jas", "James", "Jas"}},
+	{"1PE", 0, NewTestament, false, 5, [3]string{"1 Peter", "1 Peter", "1 Peter"}, [3]string{"1 Pet", "1 Pet", "1 Pet"}},
+	{"2PE", 0, NewTestament, false, 3, [3]string{"2 Peter", "2 Peter", "2 Peter"}, [3]string{"2 Pet", "2 Pet", "2 Pet"}},
+	{"1JN", 0, NewTestament, false, 5, [3]string{"1 John", "1 John", "1 John"}, [3]string{"1 John", "1 John", "1 John"}},
+	{"2JN", 0, NewTestament, false, 1, [3]string{"2 John", "2 John", "2 John"}, [3]string{"2 John", "2 John", "2 John"}},
+	{"3JN", 0, NewTestament, false, 1, [3]string{"3 John", "3 John", "3 John"}, [3]string{"3 John", "3 John", "3 John"}},
+	{"JUD", 0, NewTestament, false, 1, [3]string{"Jude", "Jude", "Jude"}, [3]string{"Jude", "Jude", "Jude"}},
+	{"REV", 0, NewTestament, false, 22, [3]string{"Revelation", "Revelation", "Revelation"}, [3]string{"Rev", "Rev", "Rev"}},
+}
+
+var (
+	booksByCode   = make(map[string]Book)
+	registryNames = make(map[string]string) // other names of the books for NormalizeBookName
+)
+
+func init() {
+	for i := range books {
+		books[i].Order = i + 1
+		booksByCode[books[i].Code] = books[i]
+	}
+
+	for _, book := range books {
+		for _, name := range append(book.names[:], book.abbreviations[:]...) {
+			name = strings.ToLower(strings.Replace(name, ".", "", -1))
+			if _, ok := registryNames[name]; !ok {
+				registryNames[name] = book.Code
+			}
+		}
+	}
+}
+
+// Books returns the books of the Bible in canonical order.
+func Books() []Book {
+	return append([]Book(nil), books...)
+}
+
+// LookupBook returns the book with the given USFM code.
+func LookupBook(code string) (Book, bool) {
+	book, ok := booksByCode[code]
+	return book, ok
+}
+
+// Returns the name of the book with the USFM code or the code itself if the
+// book is unknown.
+func bookDisplayName(code string, style BookNameStyle, abbreviated bool) string {
+	book, ok := LookupBook(code)
+	if !ok {
+		return code
+	}
+
+	if abbreviated {
+		return book.Abbreviation(style)
+	}
+	return book.Name(style)
+}
+
+// Whether references to the book are usually given as verses alone
+func isSingleChapter(code string) bool {
+	book, ok := LookupBook(code)
+	return ok && book.Chapters == 1
+}
+
+// The OSIS identifiers of the books
+var osisBooks = map[string]string{
+	"Gen": "GEN", "Exod": "EXO", "Lev": "LEV", "Num": "NUM", "Deut": "DEU",
+	"Josh": "JOS", "Judg": "JDG", "Ruth": "RUT", "1Sam": "1SA", "2Sam": "2SA",
+	"1Kgs": "1KI", "2Kgs": "2KI", "1Chr": "1CH", "2Chr": "2CH", "Ezra": "EZR",
+	"Neh": "NEH", "Esth": "EST", "Job": "JOB", "Ps": "PSA", "Prov": "PRO",
+	"Eccl": "ECC", "Song": "SNG", "Isa": "ISA", "Jer": "JER", "Lam": "LAM",
+	"Ezek": "EZK", "Dan": "DAN", "Hos": "HOS", "Joel": "JOL", "Amos": "AMO",
+	"Obad": "OBA", "Jonah": "JON", "Mic": "MIC", "Nah": "NAM", "Hab": "HAB",
+	"Zeph": "ZEP", "Hag": "HAG", "Zech": "ZEC", "Mal": "MAL",
+
+	"Tob": "TOB", "Jdt": "JDT", "EsthGr": "ESG", "AddEsth": "ESG", "Wis": "WIS",
+	"Sir": "SIR", "Bar": "BAR", "EpJer": "LJE", "PrAzar": "S3Y", "Sus": "SUS",
+	"Bel": "BEL", "1Macc": "1MA", "2Macc": "2MA", "3Macc": "3MA", "4Macc": "4MA",
+	"1Esd": "1ES", "2Esd": "2ES", "PrMan": "MAN",
+
+	"Matt": "MAT", "Mark": "MRK", "Luke": "LUK", "John": "JHN", "Acts": "ACT",
+	"Rom": "ROM", "1Cor": "1CO", "2Cor": "2CO", "Gal": "GAL", "Eph": "EPH",
+	"Phil": "PHP", "Col": "COL", "1Thess": "1TH", "2Thess": "2TH", "1Tim": "1TI",
+	"2Tim": "2TI", "Titus": "TIT", "Phlm": "PHM", "Heb": "HEB", "Jas": "JAS",
+	"1Pet": "1PE", "2Pet": "2PE", "1John": "1JN", "2John": "2JN", "3John": "3JN",
+	"Jude": "JUD", "Rev": "REV",
+}
@@ -0,0 +1,83 @@
+package orthocal_test
+
+import (
+	"errors"
+	"github.com/brianglass/orthocal"
+	"testing"
+)
+
+func TestBooks(t *testing.T) {
+	books := orthocal.Books()
+
+	t.Run("Order", func(t *testing.T) {
+		for i, book := range books {
+			if book.Order != i+1 {
+				t.Errorf("%s should be book %d but is %d.", book.Code, i+1, book.Order)
+			}
+		}
+
+		if books[0].Code != "GEN" || books[len(books)-1].Code != "REV" {
+			t.Errorf("The books should run from Genesis to Revelation but run from %s to %s.", books[0].Code, books[len(books)-1].Code)
+		}
+	})
+
+	t.Run("Names", func(t *testing.T) {
+		book, ok := orthocal.LookupBook("1SA")
+		if !ok {
+			t.Fatalf("1SA should be a book.")
+		}
+
+		if name := book.Name(orthocal.OrthodoxNames); name != "1 Kingdoms" {
+			t.Errorf("1SA should be 1 Kingdoms but is %#v.", name)
+		}
+		if name := book.Name(orthocal.ProtestantNames); name != "1 Samuel" {
+			t.Errorf("1SA should be 1 Samuel but is %#v.", name)
+		}
+		if name := book.Abbreviation(orthocal.LectionaryNames); name != "1 Kgs" {
+			t.Errorf("1SA should be abbreviated 1 Kgs but is %#v.", name)
+		}
+	})
+
+	t.Run("Classification", func(t *testing.T) {
+		wisdom, _ := orthocal.LookupBook("WIS")
+		if wisdom.Testament != orthocal.OldTestament || !wisdom.Deuterocanonical {
+			t.Errorf("Wisdom should be deuterocanonical but got %#v.", wisdom)
+		}
+
+		matthew, _ := orthocal.LookupBook("MAT")
+		if matthew.Testament != orthocal.NewTestament || matthew.Deuterocanonical || matthew.Chapters != 28 {
+			t.Errorf("Matthew has incorrect classification: %#v.", matthew)
+		}
+	})
+
+	t.Run("Normalize", func(t *testing.T) {
+		for _, book := range books {
+			for _, style := range []orthocal.BookNameStyle{orthocal.LectionaryNames, orthocal.OrthodoxNames} {
+				if code := orthocal.NormalizeBookName(book.Name(style)); code != book.Code {
+					t.Errorf("%#v should be %s but is %#v.", book.Name(style), book.Code, code)
+				}
+			}
+		}
+
+		if code := orthocal.NormalizeBookName("1 Samuel"); code != "1SA" {
+			t.Errorf("1 Samuel should be 1SA but is %#v.", code)
+		}
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		references, _ := orthocal.ParseReference("3 Kgs 17.8-24")
+
+		if s := orthocal.FormatReferences(references, orthocal.OrthodoxNames, false); s != "3 Kingdoms 17.8-24" {
+			t.Errorf("3 Kgs 17.8-24 should be 3 Kingdoms 17.8-24 but is %#v.", s)
+		}
+		if s := orthocal.FormatReferences(references, orthocal.ProtestantNames, true); s != "1 Kgs 17.8-24" {
+			t.Errorf("3 Kgs 17.8-24 should be 1 Kgs 17.8-24 but is %#v.", s)
+		}
+	})
+
+	t.Run("Chapters", func(t *testing.T) {
+		if _, e := orthocal.ParseReference("Matt 29.1-5"); !errors.Is(e, orthocal.ErrInvalidReference) {
+			t.Errorf("Matthew 29 should not exist but got %#v.", e)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+// Command orthocal-server serves the Orthodox calendar as a JSON API.
+//
+// Usage:
+//
+//	orthocal-server [-addr :8080] [-db oca_calendar.db] [-bible dir] [-versification mt]
+//
+// Without a database the calendar embedded in the program is used. The bible is a directory of USFM or OSIS files. Without it passages of
+// scripture are not available.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata" // for the tz parameter where the system has no zoneinfo
+)
+
+// How long requests have to finish when the server is shut down
+const shutdownTimeout = 10 * time.Second
+
+func main() {
+	addr := flag.String("addr", ":8080", "the address to listen on")
+	dbPath := flag.String("db", "", "a calendar database to use instead of the embedded calendar")
+	biblePath := flag.String("bible", "", "a directory of USFM or OSIS files")
+	versification := flag.String("versification", string(orthocal.VersificationMT), "the versification of the bible, lxx or mt")
+	flag.Parse()
+
+	var store orthocal.CalendarStore
+	if len(*dbPath) > 0 {
+		if _, e := os.Stat(*dbPath); e != nil {
+			log.Fatalf("Got error opening database: %s.", e)
+		}
+		db, e := sql.Open("sqlite3", *dbPath)
+		if e != nil {
+			log.Fatalf("Got error opening database: %s.", e)
+		}
+		defer db.Close()
+		store = orthocal.NewSQLiteStore(db)
+	} else {
+		s, e := orthocal.NewMemoryStore()
+		if e != nil {
+			log.Fatalf("Got error loading the calendar: %s.", e)
+		}
+		store = s
+	}
+
+	var bible orthocal.Bible
+	if len(*biblePath) > 0 {
+		b, e := orthocal.LoadBibleFiles(orthocal.Versification(*versification), *biblePath)
+		if e != nil {
+			log.Fatalf("Got error loading bible: %s.", e)
+		}
+		bible = b
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           newServer(store, bible),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Printf("Listening on %s.", *addr)
+		errs <- srv.ListenAndServe()
+	}()
+
+	select {
+	case e := <-errs:
+		log.Fatalf("Got error serving: %s.", e)
+	case <-ctx.Done():
+	}
+
+	// Let the requests that have started finish
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if e := srv.Shutdown(ctx); e != nil {
+		log.Printf("Got error shutting down: %s.", e)
+	}
+}
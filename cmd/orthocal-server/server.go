@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/brianglass/orthocal"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The API
+//
+//	/api/{calendar}/                      today
+//	/api/{calendar}/{year}/{month}/       a month
+//	/api/{calendar}/{year}/{month}/{day}/ a day
+//
+// The calendar is gregorian or julian. Dates are always given in the civil
+// (Gregorian) calendar. The time zone of today is given with the tz
+// parameter, e.g. tz=America/Chicago, and defaults to UTC. Passages of
+// scripture are included with scripture=true if the server has a Bible.
+
+// How long the days that are not today may be cached
+const maxAge = 24 * time.Hour
+
+type server struct {
+	factories map[string]*orthocal.DayFactory // by calendar
+	bible     orthocal.Bible
+	now       func() time.Time
+}
+
+// newServer returns a server for the calendar in store. The bible may be nil.
+func newServer(store orthocal.CalendarStore, bible orthocal.Bible) *server {
+	var self server
+	self.factories = map[string]*orthocal.DayFactory{
+		"gregorian": orthocal.NewDayFactoryWithStore(false, true, store),
+		"julian":    orthocal.NewDayFactoryWithStore(true, true, store),
+	}
+	self.bible = bible
+	self.now = time.Now
+	return &self
+}
+
+func (self *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] != "api" {
+		http.NotFound(w, r)
+		return
+	}
+
+	calendar := parts[1]
+	factory, ok := self.factories[calendar]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var bible orthocal.Bible
+	if scripture := r.URL.Query().Get("scripture"); len(scripture) > 0 {
+		include, e := strconv.ParseBool(scripture)
+		if e != nil {
+			http.Error(w, fmt.Sprintf("invalid scripture parameter %#v", scripture), http.StatusBadRequest)
+			return
+		}
+		if include && self.bible == nil {
+			http.Error(w, "scripture is not available", http.StatusNotImplemented)
+			return
+		}
+		if include {
+			bible = self.bible
+		}
+	}
+
+	var numbers []int
+	for _, part := range parts[2:] {
+		n, e := strconv.Atoi(part)
+		if e != nil {
+			http.NotFound(w, r)
+			return
+		}
+		numbers = append(numbers, n)
+	}
+
+	// The ETag is the same for the same date since the calendar doesn't change
+	tag := calendar
+	if bible != nil {
+		tag += "-scripture"
+	}
+
+	switch len(numbers) {
+	case 0:
+		self.serveToday(w, r, factory, bible, tag)
+	case 2:
+		days, e := factory.NewMonth(r.Context(), numbers[0], numbers[1], bible)
+		tag = fmt.Sprintf("%s-%04d-%02d", tag, numbers[0], numbers[1])
+		self.serve(w, r, days, e, tag, maxAge)
+	case 3:
+		day, e := factory.NewDayWithContext(r.Context(), numbers[0], numbers[1], numbers[2], bible)
+		tag = fmt.Sprintf("%s-%04d-%02d-%02d", tag, numbers[0], numbers[1], numbers[2])
+		self.serve(w, r, day, e, tag, maxAge)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// Serve the day that it is in the time zone of the request. It may be cached
+// until the end of the day.
+func (self *server) serveToday(w http.ResponseWriter, r *http.Request, factory *orthocal.DayFactory, bible orthocal.Bible, tag string) {
+	location := time.UTC
+	if tz := r.URL.Query().Get("tz"); len(tz) > 0 {
+		var e error
+		location, e = time.LoadLocation(tz)
+		if e != nil {
+			http.Error(w, fmt.Sprintf("unknown time zone %#v", tz), http.StatusBadRequest)
+			return
+		}
+	}
+
+	now := self.now()
+	_, today := orthocal.ResolveDate(now, location, nil)
+	year, month, date := today.Date()
+
+	day, e := factory.NewDayWithContext(r.Context(), year, int(month), date, bible)
+	tag = fmt.Sprintf("%s-%04d-%02d-%02d", tag, year, month, date)
+	self.serve(w, r, day, e, tag, today.AddDate(0, 0, 1).Sub(now))
+}
+
+// Write the days as JSON with caching headers.
+func (self *server) serve(w http.ResponseWriter, r *http.Request, days interface{}, e error, tag string, age time.Duration) {
+	if errors.Is(e, orthocal.ErrInvalidDate) {
+		http.Error(w, e.Error(), http.StatusNotFound)
+		return
+	} else if e != nil {
+		if r.Context().Err() == nil {
+			log.Printf("Got error building days: %#v.", e)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	etag := `"` + tag + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(age.Seconds())))
+
+	if match := r.Header.Get("If-None-Match"); len(match) > 0 {
+		for _, t := range strings.Split(match, ",") {
+			if t = strings.TrimSpace(t); t == etag || t == "*" {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	if e := json.NewEncoder(w).Encode(days); e != nil {
+		log.Printf("Got error writing response: %#v.", e)
+	}
+}
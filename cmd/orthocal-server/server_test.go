@@ -0,0 +1,203 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestServer(t *testing.T, bible orthocal.Bible) *server {
+	db, e := sql.Open("sqlite3", "../../oca_calendar.db")
+	if e != nil {
+		t.Fatalf("Got error opening database: %#v.", e)
+	}
+
+	return newServer(orthocal.NewSQLiteStore(db), bible)
+}
+
+func get(handler http.Handler, target string, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	for key, values := range header {
+		r.Header[key] = values
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestServer(t *testing.T) {
+	bible, e := orthocal.LoadBibleFiles(orthocal.VersificationMT, "../../testdata/kjv")
+	if e != nil {
+		t.Fatalf("Got error loading bible: %#v.", e)
+	}
+	s := newTestServer(t, bible)
+
+	t.Run("Day", func(t *testing.T) {
+		w := get(s, "/api/gregorian/2018/2/18/", nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status 200 but got %d.", w.Code)
+		}
+		if contentType := w.Header().Get("Content-Type"); contentType != "application/json; charset=utf-8" {
+			t.Errorf("Expected JSON but got %#v.", contentType)
+		}
+
+		var day orthocal.Day
+		if e := json.NewDecoder(w.Body).Decode(&day); e != nil {
+			t.Fatalf("Got error decoding day: %#v.", e)
+		}
+		if day.Year != 2018 || day.Month != 2 || day.Day != 18 {
+			t.Errorf("Expected 2/18/2018 but got %d/%d/%d.", day.Month, day.Day, day.Year)
+		}
+		if len(day.Readings) == 0 || len(day.Readings[0].Passage) != 0 {
+			t.Errorf("Expected readings without scripture.")
+		}
+	})
+
+	t.Run("Memory Store", func(t *testing.T) {
+		store, e := orthocal.NewMemoryStore()
+		if e != nil {
+			t.Fatalf("Got error loading the embedded calendar: %#v.", e)
+		}
+
+		expected := get(s, "/api/gregorian/2018/2/18/", nil).Body.String()
+		actual := get(newServer(store, bible), "/api/gregorian/2018/2/18/", nil).Body.String()
+		if actual != expected {
+			t.Errorf("2/18/2018 from memory differs from the database: %s.", actual)
+		}
+	})
+
+	t.Run("Year 0", func(t *testing.T) {
+		w := get(s, "/api/gregorian/0/1/1/", nil)
+
+		var day orthocal.Day
+		if e := json.NewDecoder(w.Body).Decode(&day); e != nil {
+			t.Fatalf("Got error decoding day: %#v.", e)
+		}
+		if day.Year != 0 || day.Month != 1 || day.Day != 1 {
+			t.Errorf("Expected 1/1/0 but got %d/%d/%d.", day.Month, day.Day, day.Year)
+		}
+	})
+
+	t.Run("Julian", func(t *testing.T) {
+		w := get(s, "/api/julian/2019/1/7", nil)
+
+		var day orthocal.Day
+		if e := json.NewDecoder(w.Body).Decode(&day); e != nil {
+			t.Fatalf("Got error decoding day: %#v.", e)
+		}
+		if day.Month != 12 || day.Day != 25 {
+			t.Errorf("1/7/2019 should be 12/25 of the Julian calendar but is %d/%d.", day.Month, day.Day)
+		}
+	})
+
+	t.Run("Month", func(t *testing.T) {
+		w := get(s, "/api/gregorian/2018/2/", nil)
+
+		var days []orthocal.Day
+		if e := json.NewDecoder(w.Body).Decode(&days); e != nil {
+			t.Fatalf("Got error decoding month: %#v.", e)
+		}
+		if len(days) != 28 {
+			t.Errorf("February 2018 should have 28 days but has %d.", len(days))
+		}
+	})
+
+	t.Run("Scripture", func(t *testing.T) {
+		w := get(s, "/api/gregorian/2018/2/18/?scripture=true", nil)
+
+		var day orthocal.Day
+		if e := json.NewDecoder(w.Body).Decode(&day); e != nil {
+			t.Fatalf("Got error decoding day: %#v.", e)
+		}
+		if len(day.Readings[0].Passage) != 12 {
+			t.Errorf("2/18/2018's first reading should be 12 verses long but is %d.", len(day.Readings[0].Passage))
+		}
+
+		w = get(newTestServer(t, nil), "/api/gregorian/2018/2/18/?scripture=true", nil)
+		if w.Code != http.StatusNotImplemented {
+			t.Errorf("Scripture without a bible should have status 501 but got %d.", w.Code)
+		}
+	})
+
+	t.Run("Today", func(t *testing.T) {
+		// 3:00 on 2/18/2018 in UTC is still 2/17/2018 in Chicago
+		s := newTestServer(t, nil)
+		s.now = func() time.Time { return time.Date(2018, 2, 18, 3, 0, 0, 0, time.UTC) }
+
+		tests := map[string]int{"": 18, "?tz=UTC": 18, "?tz=America/Chicago": 17}
+		for query, expected := range tests {
+			w := get(s, "/api/gregorian/"+query, nil)
+
+			var day orthocal.Day
+			if e := json.NewDecoder(w.Body).Decode(&day); e != nil {
+				t.Fatalf("Got error decoding day: %#v.", e)
+			}
+			if day.Day != expected {
+				t.Errorf("Today with %#v should be 2/%d/2018 but is %d/%d/%d.", query, expected, day.Month, day.Day, day.Year)
+			}
+		}
+
+		// Today may be cached until midnight
+		w := get(s, "/api/gregorian/", nil)
+		if cache := w.Header().Get("Cache-Control"); cache != "public, max-age=75600" {
+			t.Errorf("Today should be cached until midnight but got %#v.", cache)
+		}
+
+		w = get(s, "/api/gregorian/?tz=Nowhere/Special", nil)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("An unknown time zone should have status 400 but got %d.", w.Code)
+		}
+	})
+
+	t.Run("ETag", func(t *testing.T) {
+		w := get(s, "/api/gregorian/2018/2/18/", nil)
+		etag := w.Header().Get("ETag")
+		if etag != `"gregorian-2018-02-18"` {
+			t.Errorf("Expected the ETag of the date but got %#v.", etag)
+		}
+		if cache := w.Header().Get("Cache-Control"); cache != "public, max-age=86400" {
+			t.Errorf("Expected the day to be cached for a day but got %#v.", cache)
+		}
+
+		w = get(s, "/api/gregorian/2018/2/18/", http.Header{"If-None-Match": {etag}})
+		if w.Code != http.StatusNotModified {
+			t.Errorf("A matching ETag should have status 304 but got %d.", w.Code)
+		}
+
+		w = get(s, "/api/gregorian/2018/2/18/?scripture=true", nil)
+		if w.Header().Get("ETag") == etag {
+			t.Errorf("Days with and without scripture should have different ETags.")
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := map[string]int{
+			"/api/gregorian/2018/2/30/":    http.StatusNotFound,
+			"/api/gregorian/2018/13/":      http.StatusNotFound,
+			"/api/coptic/2018/2/18/":       http.StatusNotFound,
+			"/api/gregorian/2018/feb/18/":  http.StatusNotFound,
+			"/api/gregorian/2018/":         http.StatusNotFound,
+			"/api/gregorian/?scripture=ok": http.StatusBadRequest,
+			"/other":                       http.StatusNotFound,
+		}
+
+		for target, expected := range tests {
+			if w := get(s, target, nil); w.Code != expected {
+				t.Errorf("%s should have status %d but got %d.", target, expected, w.Code)
+			}
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/api/gregorian/2018/2/18/", nil)
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, r)
+		if w.Code != http.StatusMethodNotAllowed {
+			t.Errorf("POST should have status 405 but got %d.", w.Code)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/brianglass/orthocal"
+	"io"
+	"strings"
+	"time"
+)
+
+// A dayWriter writes days in a format. Days of the Julian calendar are also
+// given by their Julian date.
+type dayWriter func(w io.Writer, days []*orthocal.Day, julian bool) error
+
+var formats = map[string]dayWriter{
+	"text":     writeText,
+	"markdown": writeMarkdown,
+}
+
+// Returns the civil date of the day, followed by the Julian date for the
+// Julian calendar, e.g. Monday, January 7, 2019 (December 25, 2018 Julian).
+func heading(day *orthocal.Day, julian bool) string {
+	year, month, date := orthocal.JDNToGregorianDate(day.JDN)
+	heading := time.Date(year, time.Month(month), date, 0, 0, 0, 0, time.UTC).Format("Monday, January 2, 2006")
+
+	if julian {
+		heading += fmt.Sprintf(" (%s %d, %d Julian)", time.Month(day.Month), day.Day, day.Year)
+	}
+
+	return heading
+}
+
+func writeText(out io.Writer, days []*orthocal.Day, julian bool) error {
+	w := bufio.NewWriter(out)
+
+	for i, day := range days {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintln(w, heading(day, julian))
+		for _, title := range day.Titles {
+			fmt.Fprintln(w, title)
+		}
+		if day.Tone > 0 {
+			fmt.Fprintf(w, "Tone %d\n", day.Tone)
+		}
+
+		writeTextList(w, "Feasts", day.Feasts)
+		writeTextList(w, "Saints", day.Saints)
+
+		fmt.Fprintf(w, "\nFasting: %s\n", day.FastSummary())
+
+		if len(day.Readings) > 0 {
+			fmt.Fprint(w, "\nReadings:\n")
+			for _, reading := range day.Readings {
+				fmt.Fprintf(w, "  %s: %s\n", reading.Source, reading.Display)
+				for _, verse := range reading.Passage {
+					fmt.Fprintf(w, "    %d %s\n", verse.Verse, verse.Content)
+				}
+			}
+		}
+	}
+
+	return w.Flush()
+}
+
+func writeTextList(w io.Writer, name string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n%s:\n", name)
+	for _, item := range items {
+		fmt.Fprintf(w, "  %s\n", item)
+	}
+}
+
+func writeMarkdown(out io.Writer, days []*orthocal.Day, julian bool) error {
+	w := bufio.NewWriter(out)
+
+	for i, day := range days {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "## %s\n", heading(day, julian))
+		if len(day.Titles) > 0 {
+			fmt.Fprintf(w, "\n**%s**\n", escapeMarkdown(strings.Join(day.Titles, "; ")))
+		}
+		if day.Tone > 0 {
+			fmt.Fprintf(w, "\n*Tone %d*\n", day.Tone)
+		}
+
+		writeMarkdownList(w, "Feasts", day.Feasts)
+		writeMarkdownList(w, "Saints", day.Saints)
+
+		fmt.Fprintf(w, "\n### Fasting\n\n%s\n", escapeMarkdown(day.FastSummary()))
+
+		if len(day.Readings) > 0 {
+			fmt.Fprint(w, "\n### Readings\n\n")
+			for _, reading := range day.Readings {
+				fmt.Fprintf(w, "- **%s**: %s\n", escapeMarkdown(reading.Source), escapeMarkdown(reading.Display))
+				if len(reading.Passage) > 0 {
+					fmt.Fprintln(w)
+				}
+				for _, verse := range reading.Passage {
+					fmt.Fprintf(w, "  > <sup>%d</sup> %s\n", verse.Verse, escapeMarkdown(verse.Content))
+				}
+			}
+		}
+	}
+
+	return w.Flush()
+}
+
+func writeMarkdownList(w io.Writer, name string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n### %s\n\n", name)
+	for _, item := range items {
+		fmt.Fprintf(w, "- %s\n", escapeMarkdown(item))
+	}
+}
+
+var markdownReplacer = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "<", `\<`)
+
+// Escape the characters that would otherwise be read as markup.
+func escapeMarkdown(text string) string {
+	return markdownReplacer.Replace(text)
+}
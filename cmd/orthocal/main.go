@@ -0,0 +1,152 @@
+// Command orthocal prints the Orthodox calendar for a date, a week or a month.
+//
+// Usage:
+//
+//	orthocal [flags] [YYYY-MM-DD]
+//
+// The date is a civil (Gregorian) date and defaults to today. The week is the
+// one that begins on the Sunday on or before the date and the month is the
+// one that contains it. Passages of scripture are printed with -scripture
+// from a directory of USFM or OSIS files given with -bible. The calendar
+// embedded in the program is used unless a database is given with -db.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"io"
+	"os"
+	"time"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// Run the command and return its exit status.
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("orthocal", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	dbPath := flags.String("db", "", "a calendar database to use instead of the embedded calendar")
+	julian := flags.Bool("julian", false, "use the Julian calendar")
+	noJump := flags.Bool("no-jump", false, "do not apply the lectionary jump")
+	week := flags.Bool("week", false, "print the week of the date")
+	month := flags.Bool("month", false, "print the month of the date")
+	asJSON := flags.Bool("json", false, "print JSON")
+	format := flags.String("format", "text", "the output format, text or markdown")
+	scripture := flags.Bool("scripture", false, "print the passages of the readings")
+	biblePath := flags.String("bible", "", "a directory of USFM or OSIS files")
+	versification := flags.String("versification", string(orthocal.VersificationMT), "the versification of the bible, lxx or mt")
+
+	if e := flags.Parse(args); e != nil {
+		return 2
+	}
+
+	write, ok := formats[*format]
+	if !ok {
+		fmt.Fprintf(stderr, "Unknown format %#v.\n", *format)
+		return 2
+	}
+	if *week && *month {
+		fmt.Fprintln(stderr, "Only one of -week and -month may be given.")
+		return 2
+	}
+	if flags.NArg() > 1 {
+		flags.Usage()
+		return 2
+	}
+
+	date := time.Now()
+	if flags.NArg() == 1 {
+		var e error
+		date, e = time.ParseInLocation("2006-01-02", flags.Arg(0), time.Local)
+		if e != nil {
+			fmt.Fprintf(stderr, "Invalid date %#v.\n", flags.Arg(0))
+			return 2
+		}
+	}
+
+	var bible orthocal.Bible
+	if *scripture {
+		if len(*biblePath) == 0 {
+			fmt.Fprintln(stderr, "-scripture requires -bible.")
+			return 2
+		}
+
+		b, e := orthocal.LoadBibleFiles(orthocal.Versification(*versification), *biblePath)
+		if e != nil {
+			fmt.Fprintf(stderr, "Got error loading bible: %s.\n", e)
+			return 1
+		}
+		bible = b
+	}
+
+	var store orthocal.CalendarStore
+	if len(*dbPath) > 0 {
+		if _, e := os.Stat(*dbPath); e != nil {
+			fmt.Fprintf(stderr, "Got error opening database: %s.\n", e)
+			return 1
+		}
+		db, e := sql.Open("sqlite3", *dbPath)
+		if e != nil {
+			fmt.Fprintf(stderr, "Got error opening database: %s.\n", e)
+			return 1
+		}
+		defer db.Close()
+		store = orthocal.NewSQLiteStore(db)
+	} else {
+		s, e := orthocal.NewMemoryStore()
+		if e != nil {
+			fmt.Fprintf(stderr, "Got error loading the calendar: %s.\n", e)
+			return 1
+		}
+		store = s
+	}
+
+	factory := orthocal.NewDayFactoryWithStore(*julian, !*noJump, store)
+
+	start, end := date, date
+	switch {
+	case *week:
+		start = date.AddDate(0, 0, -int(date.Weekday()))
+		end = start.AddDate(0, 0, 6)
+	case *month:
+		start = date.AddDate(0, 0, 1-date.Day())
+		end = start.AddDate(0, 1, -1)
+	}
+
+	days, e := factory.NewDaysInRange(context.Background(), start, end, bible)
+	if e != nil {
+		fmt.Fprintf(stderr, "Got error building days: %s.\n", e)
+		return 1
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "\t")
+
+		// A single date is printed as a day rather than a list of days
+		var v interface{} = days
+		if !*week && !*month {
+			v = days[0]
+		}
+		if e := encoder.Encode(v); e != nil {
+			fmt.Fprintf(stderr, "Got error writing JSON: %s.\n", e)
+			return 1
+		}
+		return 0
+	}
+
+	if e := write(stdout, days, *julian); e != nil {
+		fmt.Fprintf(stderr, "Got error writing days: %s.\n", e)
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/brianglass/orthocal"
+	"strings"
+	"testing"
+)
+
+func runCommand(t *testing.T, args ...string) (string, string, int) {
+	var stdout, stderr bytes.Buffer
+
+	args = append([]string{"-db", "../../oca_calendar.db"}, args...)
+	status := run(args, &stdout, &stderr)
+	return stdout.String(), stderr.String(), status
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Text", func(t *testing.T) {
+		stdout, stderr, status := runCommand(t, "2018-02-18")
+		if status != 0 {
+			t.Fatalf("Expected status 0 but got %d: %s", status, stderr)
+		}
+
+		for _, expected := range []string{
+			"Sunday, February 18, 2018\n",
+			"Sunday of Cheesefare: Expulsion of Adam from Paradise\n",
+			"Tone 4\n",
+			"\nFeasts:\n  Forgiveness Sunday\n",
+			"\nFasting: Fast (Meat Fast)\n",
+			"\nReadings:\n",
+			"  Gospel: Matthew 6.14-21\n",
+		} {
+			if !strings.Contains(stdout, expected) {
+				t.Errorf("The output should contain %#v but is %#v.", expected, stdout)
+			}
+		}
+	})
+
+	t.Run("Markdown", func(t *testing.T) {
+		stdout, _, status := runCommand(t, "-format", "markdown", "-scripture", "-bible", "../../testdata/kjv", "2018-02-18")
+		if status != 0 {
+			t.Fatalf("Expected status 0 but got %d.", status)
+		}
+
+		for _, expected := range []string{
+			"## Sunday, February 18, 2018\n",
+			"\n**Sunday of Cheesefare: Expulsion of Adam from Paradise**\n",
+			"\n### Feasts\n\n- Forgiveness Sunday\n",
+			"- **Gospel**: Matthew 6.14-21\n",
+			"  > <sup>21</sup> For where your treasure is, there will your heart be also.\n",
+		} {
+			if !strings.Contains(stdout, expected) {
+				t.Errorf("The output should contain %#v but is %#v.", expected, stdout)
+			}
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		stdout, _, _ := runCommand(t, "-json", "2018-02-18")
+
+		var day orthocal.Day
+		if e := json.Unmarshal([]byte(stdout), &day); e != nil {
+			t.Fatalf("Got error decoding day: %#v.", e)
+		}
+		if day.Month != 2 || day.Day != 18 {
+			t.Errorf("Expected 2/18/2018 but got %d/%d/%d.", day.Month, day.Day, day.Year)
+		}
+	})
+
+	t.Run("Ranges", func(t *testing.T) {
+		tests := []struct {
+			flag  string
+			first int
+			count int
+		}{
+			{"-week", 11, 7},
+			{"-month", 1, 28},
+		}
+
+		for _, test := range tests {
+			stdout, _, _ := runCommand(t, "-json", test.flag, "2018-02-14")
+
+			var days []orthocal.Day
+			if e := json.Unmarshal([]byte(stdout), &days); e != nil {
+				t.Fatalf("Got error decoding days: %#v.", e)
+			}
+			if len(days) != test.count || days[0].Day != test.first {
+				t.Errorf("%s of 2/14/2018 should be %d days from 2/%d but got %d days from 2/%d.", test.flag, test.count, test.first, len(days), days[0].Day)
+			}
+		}
+	})
+
+	t.Run("Julian", func(t *testing.T) {
+		stdout, _, _ := runCommand(t, "-julian", "2019-01-07")
+		if !strings.HasPrefix(stdout, "Monday, January 7, 2019 (December 25, 2018 Julian)\n") {
+			t.Errorf("1/7/2019 should be Nativity in the Julian calendar but got %#v.", stdout)
+		}
+
+		stdout, _, _ = runCommand(t, "-julian", "-no-jump", "-json", "2019-01-07")
+		var day orthocal.Day
+		if e := json.Unmarshal([]byte(stdout), &day); e != nil || day.Day != 25 {
+			t.Errorf("1/7/2019 without the jump should be 12/25 but got %#v.", stdout)
+		}
+	})
+
+	t.Run("Embedded Calendar", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		if status := run([]string{"2018-02-18"}, &stdout, &stderr); status != 0 {
+			t.Fatalf("Expected status 0 but got %d: %s", status, stderr.String())
+		}
+
+		expected, _, _ := runCommand(t, "2018-02-18")
+		if stdout.String() != expected {
+			t.Errorf("The embedded calendar should give %#v but gave %#v.", expected, stdout.String())
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, args := range [][]string{
+			{"2018-02-30"},
+			{"-format", "html"},
+			{"-week", "-month"},
+			{"-scripture"},
+			{"2018-02-18", "2018-02-19"},
+		} {
+			if _, stderr, status := runCommand(t, args...); status != 2 || len(stderr) == 0 {
+				t.Errorf("%#v should fail with status 2 and a message but got %d.", args, status)
+			}
+		}
+	})
+}
@@ -1,5 +1,8 @@
+# Build oca_calendar.db from the sql files. Run this again whenever they
+# change, e.g. to divide an older database's composites into verses.
 rm oca_calendar.db
 sqlite3 oca_calendar.db < sql/days.sql
 sqlite3 oca_calendar.db < sql/readings.sql
 sqlite3 oca_calendar.db < sql/pericopes.sql
 sqlite3 oca_calendar.db < sql/composites.sql
+sqlite3 oca_calendar.db < sql/composite_references.sql
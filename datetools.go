@@ -73,9 +73,9 @@ var FeastLevels = map[int]string{
 func ComputeJulianPascha(year int) (int, int) {
 	// Use the Meeus Julian algorithm to calculate the Julian date
 	// See https://en.wikipedia.org/wiki/Computus#Meeus'_Julian_algorithm
-	a := year % 4
-	b := year % 7
-	c := year % 19
+	a := floorMod(year, 4)
+	b := floorMod(year, 7)
+	c := floorMod(year, 19)
 	d := (19*c + 15) % 30
 	e := (2*a + 4*b - d + 34) % 7
 	month := (d + e + 114) / 31
@@ -90,7 +90,6 @@ func ComputePaschaJDN(year int) int {
 }
 
 // Compute the Gregorian date of Pascha for the given year.
-// The year must be between 2001 and 2099.
 func ComputeGregorianPascha(year int) (time.Time, error) {
 	month, day := ComputeJulianPascha(year)
 
@@ -149,43 +148,35 @@ func SurroundingWeekends(distance int) (int, int, int, int) {
 // Conversion functions
 
 // Convert a Julian date to a Gregorian date.
+//
+// The conversion is done through the Julian day number, so it is exact for
+// any year and accounts for the differing century leap year rules of the two
+// calendars.
 func JulianToGregorian(year, month, day int) (time.Time, error) {
-	// This function will be incorrect outside the range 2001-2099 for 2 reasons:
-	//
-	// 1. The offset of 13 is incorrect outside the range 1900-2099.
-	// 2. If the Julian date is in February and on a year that is divisible by
-	//    100, the Go time module will incorrectly add the offset because these years
-	//    are leap years on the Julian, but not on the Gregorian.
-	//
-	// Hopefully this code will no longer be running by 2100.
-
-	if year < 2001 || year > 2099 {
-		return time.Now(), errors.New("The year must be between 1900 and 2099")
+	if !isValidJulianDate(year, month, day) {
+		return time.Now(), errors.New("Invalid Julian date")
 	}
 
-	// Add an offset of 13 to convert from Julian to Gregorian
-	julianDate := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local)
-	return julianDate.AddDate(0, 0, 13), nil
+	year, month, day = JDNToGregorianDate(JulianDateToJDN(year, month, day))
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local), nil
 }
 
 // Convert a Gregorian date to a Julian date.
+//
+// Since time.Time follows the Gregorian rules, a Julian leap day that does
+// not exist on the Gregorian calendar (e.g. 2/29/2100) cannot be represented
+// and results in an error.
 func GregorianToJulian(year, month, day int) (time.Time, error) {
-	// This function will be incorrect outside the range 2001-2099 for 2 reasons:
-	//
-	// 1. The offset of 13 is incorrect outside the range 1900-2099.
-	// 2. If the Julian date is in February and on a year that is divisible by
-	//    100, the Go time module will incorrectly add the offset because these years
-	//    are leap years on the Julian, but not on the Gregorian.
-	//
-	// Hopefully this code will no longer be running by 2100.
-
-	if year < 2001 || year > 2099 {
-		return time.Now(), errors.New("The year must be between 1900 and 2099")
+	if !isValidGregorianDate(year, month, day) {
+		return time.Now(), errors.New("Invalid Gregorian date")
 	}
 
-	// Add an offset of 13 to convert from Julian to Gregorian
-	gregorianDate := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local)
-	return gregorianDate.AddDate(0, 0, -13), nil
+	year, month, day = JDNToJulianDate(GregorianDateToJDN(year, month, day))
+	if month == 2 && day == 29 && !isGregorianLeapYear(year) {
+		return time.Now(), errors.New("The Julian date falls on a leap day that time.Time cannot represent")
+	}
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local), nil
 }
 
 // Convert a Julian date to a Julian day number.
@@ -195,7 +186,8 @@ func JulianDateToJDN(year, month, day int) int {
 }
 
 // Convert a Gregorian date to a Julian day number.
-// This function mimic's PHP's gregoriantojd().
+// This function mimic's PHP's gregoriantojd(), but for years before 1 as well,
+// which are numbered astronomically so that 1 BC is year 0.
 func GregorianDateToJDN(year, month, day int) int {
 	if month > 2 {
 		month -= 3
@@ -205,8 +197,80 @@ func GregorianDateToJDN(year, month, day int) int {
 	}
 
 	// break up the year into the leftmost 2 digits (century) and the rightmost 2 digits
-	century := year / 100
+	century := floorDiv(year, 100)
 	ya := year - 100*century
 
-	return (146097*century)/4 + (1461*ya)/4 + (153*int(month)+2)/5 + day + 1721119
+	return floorDiv(146097*century, 4) + (1461*ya)/4 + (153*int(month)+2)/5 + day + 1721119
+}
+
+// Convert a Julian day number to a Julian date. This is the inverse of
+// JulianDateToJDN.
+func JDNToJulianDate(jdn int) (year, month, day int) {
+	return jdnToDate(jdn + 1401)
+}
+
+// Convert a Julian day number to a Gregorian date. This is the inverse of
+// GregorianDateToJDN.
+func JDNToGregorianDate(jdn int) (year, month, day int) {
+	return jdnToDate(jdn + 1401 + (((4*jdn+274277)/146097)*3)/4 - 38)
+}
+
+// Finish converting a Julian day number to a date. The argument has already
+// been adjusted for the calendar in use.
+func jdnToDate(f int) (year, month, day int) {
+	// See https://en.wikipedia.org/wiki/Julian_day#Julian_or_Gregorian_calendar_from_Julian_day_number
+	e := 4*f + 3
+	g := (e % 1461) / 4
+	h := 5*g + 2
+	day = (h%153)/5 + 1
+	month = (h/153+2)%12 + 1
+	year = e/1461 - 4716 + (14-month)/12
+	return year, month, day
+}
+
+// Division that rounds down rather than toward zero, so that the
+// calculations hold for years before 1
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func floorMod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
+
+// Leap years and month lengths
+
+func isJulianLeapYear(year int) bool {
+	return year%4 == 0
+}
+
+func isGregorianLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+var monthLengths = [...]int{0, 31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+func isValidJulianDate(year, month, day int) bool {
+	return isValidDate(month, day, isJulianLeapYear(year))
+}
+
+func isValidGregorianDate(year, month, day int) bool {
+	return isValidDate(month, day, isGregorianLeapYear(year))
+}
+
+func isValidDate(month, day int, leap bool) bool {
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+
+	length := monthLengths[month]
+	if month == 2 && leap {
+		length++
+	}
+
+	return day <= length
 }
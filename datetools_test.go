@@ -32,6 +32,13 @@ func TestGregorianDateToJDN(t *testing.T) {
 	if actual != expected {
 		t.Fatalf("GregorianDateToJDN should have returned %d but returned %d", expected, actual)
 	}
+
+	// Year 0 is 1 BC, which is a leap year
+	actual = orthocal.GregorianDateToJDN(0, 1, 1)
+	expected = 1721060
+	if actual != expected {
+		t.Fatalf("GregorianDateToJDN should have returned %d but returned %d", expected, actual)
+	}
 }
 
 func TestComputeGregorianPascha(t *testing.T) {
@@ -46,11 +53,21 @@ func TestComputeGregorianPascha(t *testing.T) {
 	}
 }
 
-func TestComputeGregorianPaschaInvalid(t *testing.T) {
-	expectedTime := time.Date(2100, 5, 2, 0, 0, 0, 0, time.Local)
-	_, e := orthocal.ComputeGregorianPascha(expectedTime.Year())
-	if e == nil {
-		t.Errorf("CalculateGregorianPacha should return an error when dates are out of range")
+func TestComputeGregorianPaschaOutsideCentury(t *testing.T) {
+	testCases := []time.Time{
+		time.Date(1900, 4, 22, 0, 0, 0, 0, time.Local),
+		time.Date(2100, 5, 2, 0, 0, 0, 0, time.Local),
+		time.Date(1700, 4, 11, 0, 0, 0, 0, time.Local),
+	}
+
+	for _, expectedTime := range testCases {
+		pascha, e := orthocal.ComputeGregorianPascha(expectedTime.Year())
+		if e != nil {
+			t.Errorf("CalculateGregorianPascha had an error: %#v", e)
+		}
+		if pascha != expectedTime {
+			t.Errorf("CalculateGregorianPascha should have returned %s but returned %s", expectedTime, pascha)
+		}
 	}
 }
 
@@ -88,15 +105,69 @@ func TestConvertJulianToGregorian(t *testing.T) {
 	}
 }
 
+func TestConvertJulianToGregorianOutsideCentury(t *testing.T) {
+	testCases := []struct {
+		year, month, day int
+		expected         time.Time
+	}{
+		{1582, 10, 5, time.Date(1582, 10, 15, 0, 0, 0, 0, time.Local)},
+		{1900, 2, 28, time.Date(1900, 3, 12, 0, 0, 0, 0, time.Local)},
+		{1900, 2, 29, time.Date(1900, 3, 13, 0, 0, 0, 0, time.Local)},
+		{1900, 3, 1, time.Date(1900, 3, 14, 0, 0, 0, 0, time.Local)},
+		{2100, 2, 28, time.Date(2100, 3, 13, 0, 0, 0, 0, time.Local)},
+		{2100, 2, 29, time.Date(2100, 3, 14, 0, 0, 0, 0, time.Local)},
+		{2100, 3, 1, time.Date(2100, 3, 15, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, tc := range testCases {
+		actual, e := orthocal.JulianToGregorian(tc.year, tc.month, tc.day)
+		if e != nil {
+			t.Errorf("JulianToGregorian returned error for %d/%d/%d: %#v", tc.month, tc.day, tc.year, e)
+		}
+		if actual != tc.expected {
+			t.Errorf("JulianToGregorian should have returned %v for %d/%d/%d but returned %v", tc.expected, tc.month, tc.day, tc.year, actual)
+		}
+	}
+}
+
 func TestConvertJulianToGregorianInvalid(t *testing.T) {
-	_, e := orthocal.JulianToGregorian(2100, 4, 14)
+	_, e := orthocal.JulianToGregorian(2019, 2, 29)
 	if e == nil {
-		t.Errorf("ConvertJulianToGregory should return an error when dates are out of range")
+		t.Errorf("JulianToGregorian should return an error for invalid dates")
 	}
 
-	_, e = orthocal.JulianToGregorian(1900, 4, 14)
+	_, e = orthocal.JulianToGregorian(2018, 4, 31)
 	if e == nil {
-		t.Errorf("ConvertJulianToGregory should return an error when dates are out of range")
+		t.Errorf("JulianToGregorian should return an error for invalid dates")
+	}
+}
+
+func TestConvertGregorianToJulian(t *testing.T) {
+	testCases := []struct {
+		year, month, day int
+		expected         time.Time
+	}{
+		{2008, 4, 27, time.Date(2008, 4, 14, 0, 0, 0, 0, time.Local)},
+		{1582, 10, 15, time.Date(1582, 10, 5, 0, 0, 0, 0, time.Local)},
+		{1900, 3, 12, time.Date(1900, 2, 28, 0, 0, 0, 0, time.Local)},
+		{1900, 3, 14, time.Date(1900, 3, 1, 0, 0, 0, 0, time.Local)},
+		{2100, 3, 15, time.Date(2100, 3, 1, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, tc := range testCases {
+		actual, e := orthocal.GregorianToJulian(tc.year, tc.month, tc.day)
+		if e != nil {
+			t.Errorf("GregorianToJulian returned error for %d/%d/%d: %#v", tc.month, tc.day, tc.year, e)
+		}
+		if actual != tc.expected {
+			t.Errorf("GregorianToJulian should have returned %v for %d/%d/%d but returned %v", tc.expected, tc.month, tc.day, tc.year, actual)
+		}
+	}
+
+	// 2/29/2100 exists on the Julian calendar, but not the Gregorian
+	_, e := orthocal.GregorianToJulian(2100, 3, 14)
+	if e == nil {
+		t.Errorf("GregorianToJulian should return an error for dates time.Time cannot represent")
 	}
 }
 
@@ -108,6 +179,52 @@ func TestJulianDatetoJDN(t *testing.T) {
 	}
 }
 
+func TestJDNToGregorianDate(t *testing.T) {
+	year, month, day := orthocal.JDNToGregorianDate(2458134)
+	if year != 2018 || month != 1 || day != 15 {
+		t.Errorf("JDNToGregorianDate returned %d/%d/%d but should have returned 1/15/2018", month, day, year)
+	}
+
+	// Every day should round trip, including the century leap days and the
+	// years before 1
+	for jdn := orthocal.GregorianDateToJDN(-401, 1, 1); jdn < orthocal.GregorianDateToJDN(401, 1, 1); jdn++ {
+		year, month, day := orthocal.JDNToGregorianDate(jdn)
+		if actual := orthocal.GregorianDateToJDN(year, month, day); actual != jdn {
+			t.Fatalf("JDNToGregorianDate(%d) returned %d/%d/%d which converts back to %d", jdn, month, day, year, actual)
+		}
+	}
+	for jdn := orthocal.GregorianDateToJDN(1599, 1, 1); jdn < orthocal.GregorianDateToJDN(2401, 1, 1); jdn++ {
+		year, month, day := orthocal.JDNToGregorianDate(jdn)
+		if actual := orthocal.GregorianDateToJDN(year, month, day); actual != jdn {
+			t.Fatalf("JDNToGregorianDate(%d) returned %d/%d/%d which converts back to %d", jdn, month, day, year, actual)
+		}
+	}
+}
+
+func TestJDNToJulianDate(t *testing.T) {
+	year, month, day := orthocal.JDNToJulianDate(2455676)
+	if year != 2011 || month != 4 || day != 11 {
+		t.Errorf("JDNToJulianDate returned %d/%d/%d but should have returned 4/11/2011", month, day, year)
+	}
+
+	for jdn := orthocal.JulianDateToJDN(-401, 1, 1); jdn < orthocal.JulianDateToJDN(2401, 1, 1); jdn++ {
+		year, month, day := orthocal.JDNToJulianDate(jdn)
+		if actual := orthocal.JulianDateToJDN(year, month, day); actual != jdn {
+			t.Fatalf("JDNToJulianDate(%d) returned %d/%d/%d which converts back to %d", jdn, month, day, year, actual)
+		}
+	}
+}
+
+func TestComputeJulianPaschaBeforeYear1(t *testing.T) {
+	// The Julian dates of Pascha repeat every 532 years
+	for year := -600; year < 1; year++ {
+		month, day := orthocal.ComputeJulianPascha(year)
+		if m, d := orthocal.ComputeJulianPascha(year + 532); month != m || day != d {
+			t.Errorf("Pascha of %d is %d/%d but Pascha of %d is %d/%d", year, month, day, year+532, m, d)
+		}
+	}
+}
+
 func TestComputJDNPascha(t *testing.T) {
 	expected := 2455676
 	actual := orthocal.ComputePaschaJDN(2011)
@@ -3,9 +3,11 @@ package orthocal
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,6 +16,17 @@ import (
 
 var compositeRe = regexp.MustCompile(`Composite (\d+)`)
 
+var (
+	// ErrInvalidDate is returned when the requested date does not exist.
+	ErrInvalidDate = errors.New("orthocal: invalid date")
+
+	// ErrQuery is returned when querying the calendar database fails.
+	ErrQuery = errors.New("orthocal: error querying the database")
+
+	// ErrScan is returned when a row from the calendar database cannot be read.
+	ErrScan = errors.New("orthocal: error reading a database row")
+)
+
 type Verse struct {
 	Book    string `json:"book"`
 	Chapter uint16 `json:"chapter"`
@@ -23,6 +36,9 @@ type Verse struct {
 
 type Passage []Verse
 
+// A Bible looks up the passages of the readings. A DayFactory looks up one
+// passage at a time unless SetScriptureWorkers is given more than one worker,
+// in which case the Bible must be safe for concurrent use.
 type Bible interface {
 	Lookup(reference string) Passage
 	LookupWithContext(ctx context.Context, reference string) Passage
@@ -48,65 +64,294 @@ type Day struct {
 	ServiceNotes      []string  `json:"service_notes"`
 	Readings          []Reading `json:"readings"`
 
-	pyear *Year
+	// Feasts and saints ordered by feast level, highest first
+	Commemorations []Commemoration `json:"commemorations"`
+
+	// The services of the evening, if the factory includes them
+	Eve *Eve `json:"eve,omitempty"`
+
+	pyear      *Year
+	noMemorial bool // the readings for the departed are removed
+}
+
+// A Commemoration is a feast or saint remembered on a day.
+type Commemoration struct {
+	Name           string `json:"name"`
+	FeastLevel     int    `json:"feast_level"`
+	FeastLevelDesc string `json:"feast_level_description"`
+
+	// The principal commemoration of the day. At most one commemoration of a
+	// day is primary.
+	Primary bool `json:"primary"`
+
+	// Movable commemorations come from the Paschal cycle or are floats.
+	// PDist is the distance from Pascha for the Paschal cycle and the float
+	// index, 1001 and up, for floats, whose distance from Pascha differs
+	// from year to year. Fixed commemorations are identified by Month and
+	// Day.
+	Movable bool `json:"movable"`
+	PDist   int  `json:"pascha_distance"`
+	Month   int  `json:"month"`
+	Day     int  `json:"day"`
+
+	title string // the title of the day that comes with the commemoration
 }
 
 type Reading struct {
-	Source       string  `json:"source"`
-	Book         string  `json:"book"`
-	Description  string  `json:"description"`
-	Display      string  `json:"display"`
-	ShortDisplay string  `json:"short_display"`
-	Passage      Passage `json:"passage"`
+	Source              string   `json:"source"`
+	Book                string   `json:"book"`
+	Description         string   `json:"description"`
+	Display             string   `json:"display"`
+	ShortDisplay        string   `json:"short_display"`
+	Pericope            string   `json:"pericope"`  // e.g. 35ctr or 54|58
+	Pericopes           []string `json:"pericopes"` // e.g. 54 and 58
+	PericopeDescription string   `json:"pericope_description"`
+	Ordering            int      `json:"ordering"`
+	Passage             Passage  `json:"passage"`
+	Translation         string   `json:"translation"` // the name of the Translation of the passage
+
+	pericope PericopeRecord // for the incipit and suffix
+	evening  bool           // read at the Vespers of the day's own evening
+	fixed    bool           // from the fixed calendar
 }
 
+// HasNoMemorial returns whether one of the factory's exceptions removes the
+// memorial readings for the departed from the day.
 func (self *Day) HasNoMemorial() bool {
-	return ((self.PDist == -36 || self.PDist == -29 || self.PDist == -22) &&
-		(self.Month == 3) &&
-		(self.Day == 9 || self.Day == 24 || self.Day == 25 || self.Day == 26))
+	return self.noMemorial
+}
+
+// FastSummary describes the fast of the day with its exception, e.g. Lenten
+// Fast (Wine and Oil are Allowed).
+func (self *Day) FastSummary() string {
+	if self.FastLevel == NoFast && self.FastException == 11 {
+		return self.FastExceptionDesc
+	}
+	if self.FastException == 0 || self.FastException == 10 {
+		return self.FastLevelDesc
+	}
+
+	return fmt.Sprintf("%s (%s)", self.FastLevelDesc, self.FastExceptionDesc)
 }
 
 type DayFactory struct {
-	db        *sql.DB
+	store     CalendarStore
 	useJulian bool
 	doJump    bool
 	years     sync.Map
+
+	exceptions       []Exception
+	rawScripture     bool
+	scriptureWorkers int
+	eveServices      bool
 }
 
+// NewDayFactory returns a DayFactory that reads the calendar from a SQLite
+// database created with createdb.sh.
 func NewDayFactory(useJulian bool, doJump bool, db *sql.DB) *DayFactory {
+	return NewDayFactoryWithStore(useJulian, doJump, NewSQLiteStore(db))
+}
+
+// NewDayFactoryWithStore returns a DayFactory that reads the calendar from
+// the given store.
+func NewDayFactoryWithStore(useJulian bool, doJump bool, store CalendarStore) *DayFactory {
 	var self DayFactory
-	self.db = db
+	self.store = store
 	self.useJulian = useJulian
 	self.doJump = doJump
+	self.exceptions = builtinExceptions
+	self.scriptureWorkers = defaultScriptureWorkers
 	return &self
 }
 
+// SetRawScripture sets whether passages are returned exactly as they are in
+// the Bible rather than as they are read in church, with the incipit and
+// suffix of the pericope. SetRawScripture must not be called while the
+// factory is building days.
+func (self *DayFactory) SetRawScripture(raw bool) {
+	self.rawScripture = raw
+}
+
+// SetScriptureWorkers sets the number of passages that are looked up in the
+// Bible at once. The Bible must be safe for concurrent use if workers is more
+// than 1. SetScriptureWorkers must not be called while the factory is
+// building days.
+func (self *DayFactory) SetScriptureWorkers(workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+	self.scriptureWorkers = workers
+}
+
+// SetEveServices sets whether each Day includes the services of its evening,
+// which begin the next day. SetEveServices must not be called while the
+// factory is building days.
+func (self *DayFactory) SetEveServices(eve bool) {
+	self.eveServices = eve
+}
+
+// NewDay is like NewDayWithContext except that errors are logged rather than
+// returned. The Day is never nil. If there is an error, it has only its date,
+// pdist and weekday, and a date that does not exist is wrapped as time.Date
+// wraps it, e.g. April 31 to May 1.
 func (self *DayFactory) NewDay(year, month, day int, bible Bible) *Day {
-	return self.NewDayWithContext(context.Background(), year, month, day, bible)
+	d, e := self.NewDayWithContext(context.Background(), year, month, day, bible)
+	if e != nil {
+		log.Printf("Got error building day %d/%d/%d: %#v.", month, day, year, e)
+
+		date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		d = self.initDay(GregorianDateToJDN(date.Year(), int(date.Month()), date.Day()))
+	}
+
+	return d
 }
 
-func (self *DayFactory) NewDayWithContext(ctx context.Context, year, month, day int, bible Bible) *Day {
-	var d Day
-	var pdist, pyear int
-	var date time.Time
+// NewDayWithContext builds the Day for the given Gregorian date. If the
+// factory uses the Julian calendar, the date is converted to the Julian
+// calendar before anything is computed. Scripture is included in the readings
+// if bible is not nil.
+func (self *DayFactory) NewDayWithContext(ctx context.Context, year, month, day int, bible Bible) (*Day, error) {
+	if !isValidGregorianDate(year, month, day) {
+		return nil, fmt.Errorf("%w: %d/%d/%d", ErrInvalidDate, month, day, year)
+	}
 
-	// time.Date automatically wraps dates that are invalid to the next month.
-	// e.g. April 31 -> May 1
-	if self.useJulian {
-		date, _ = GregorianToJulian(year, month, day)
-	} else {
-		date = time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local)
+	jdn := GregorianDateToJDN(year, month, day)
+	days, e := self.newDays(ctx, jdn, jdn, bible)
+	if e != nil {
+		return nil, e
+	}
+
+	return days[0], nil
+}
+
+// NewDaysInRange builds the Days from start through end inclusive. Only the
+// Gregorian dates of start and end are used; the time of day and location are
+// ignored. The commemorations and readings for the whole range are fetched
+// together rather than one day at a time.
+func (self *DayFactory) NewDaysInRange(ctx context.Context, start, end time.Time, bible Bible) ([]*Day, error) {
+	first := GregorianDateToJDN(start.Year(), int(start.Month()), start.Day())
+	last := GregorianDateToJDN(end.Year(), int(end.Month()), end.Day())
+	if last < first {
+		return nil, fmt.Errorf("%w: %s is before %s", ErrInvalidDate, end.Format("1/2/2006"), start.Format("1/2/2006"))
+	}
+
+	return self.newDays(ctx, first, last, bible)
+}
+
+// NewMonth builds the Days of the given Gregorian month.
+func (self *DayFactory) NewMonth(ctx context.Context, year, month int, bible Bible) ([]*Day, error) {
+	if month < 1 || month > 12 {
+		return nil, fmt.Errorf("%w: month %d", ErrInvalidDate, month)
+	}
+
+	first := GregorianDateToJDN(year, month, 1)
+	last := GregorianDateToJDN(year, month+1, 1) - 1
+
+	return self.newDays(ctx, first, last, bible)
+}
+
+// NewYearCalendar builds the Days of the given Gregorian year.
+func (self *DayFactory) NewYearCalendar(ctx context.Context, year int, bible Bible) ([]*Day, error) {
+	first := GregorianDateToJDN(year, 1, 1)
+	last := GregorianDateToJDN(year+1, 1, 1) - 1
+
+	return self.newDays(ctx, first, last, bible)
+}
+
+// The maximum number of days whose commemorations or readings are fetched
+// from the store at once.
+const maxBatchDays = 64
+
+// Build the Days for the Julian day numbers first through last inclusive.
+func (self *DayFactory) newDays(ctx context.Context, first, last int, bible Bible) ([]*Day, error) {
+	if e := ctx.Err(); e != nil {
+		return nil, e
+	}
+
+	// Exceptions may move readings and commemorations from days outside of
+	// the range, so those days are built too.
+	margin := self.exceptionMargin()
+
+	// The eve of the last day comes from the day after it
+	if self.eveServices {
+		last++
+	}
+
+	days := make([]*Day, 0, last-first+1+2*margin)
+	for jdn := first - margin; jdn <= last+margin; jdn++ {
+		days = append(days, self.initDay(jdn))
+	}
+
+	for i := 0; i < len(days); i += maxBatchDays {
+		batch := days[i:]
+		if len(batch) > maxBatchDays {
+			batch = batch[:maxBatchDays]
+		}
+
+		// The readings depend on the feast level, so the commemorations must
+		// come first.
+		if e := self.addCommemorations(ctx, batch); e != nil {
+			return nil, e
+		}
+		if e := self.addReadings(ctx, batch); e != nil {
+			return nil, e
+		}
+	}
+
+	if e := self.applyExceptions(ctx, days); e != nil {
+		return nil, e
+	}
+	days = days[margin : len(days)-margin]
+
+	if bible != nil {
+		var readings []*Reading
+		for i, day := range days {
+			for j := range day.Readings {
+				reading := &day.Readings[j]
+
+				// Of the day after the range, only the readings of the eve
+				// of the last day are kept.
+				if self.eveServices && i == len(days)-1 && !reading.isEveOfDay() {
+					continue
+				}
+				readings = append(readings, reading)
+			}
+		}
+
+		if e := self.addScriptures(ctx, readings, bible); e != nil {
+			return nil, e
+		}
+	}
+
+	for _, day := range days {
+		self.addTone(day)
+		self.addFastingAdjustments(day)
+	}
+
+	if self.eveServices {
+		for i := 0; i < len(days)-1; i++ {
+			days[i].Eve = newEve(days[i], days[i+1])
+		}
+		days = days[:len(days)-1]
 	}
-	d.Year, d.Month, d.Day = date.Year(), int(date.Month()), date.Day()
+
+	return days, nil
+}
+
+// Initialize the date related fields of the Day for the given Julian day
+// number.
+func (self *DayFactory) initDay(jdn int) *Day {
+	var d Day
+	var pyear int
 
 	if self.useJulian {
-		pdist, pyear = ComputeJulianPaschaDistance(d.Year, d.Month, d.Day)
-		d.JDN = JulianDateToJDN(d.Year, d.Month, d.Day)
+		d.Year, d.Month, d.Day = JDNToJulianDate(jdn)
+		d.PDist, pyear = ComputeJulianPaschaDistance(d.Year, d.Month, d.Day)
 	} else {
-		pdist, pyear = ComputePaschaDistance(d.Year, d.Month, d.Day)
-		d.JDN = GregorianDateToJDN(d.Year, d.Month, d.Day)
+		d.Year, d.Month, d.Day = JDNToGregorianDate(jdn)
+		d.PDist, pyear = ComputePaschaDistance(d.Year, d.Month, d.Day)
 	}
-	d.PDist = pdist
+	d.JDN = jdn
 	d.Weekday = WeekDayFromPDist(d.PDist)
 
 	// Cache years in a thread-safe way
@@ -117,211 +362,321 @@ func (self *DayFactory) NewDayWithContext(ctx context.Context, year, month, day
 		self.years.Store(pyear, d.pyear)
 	}
 
-	self.addCommemorations(ctx, &d)
-	self.addReadings(ctx, &d, bible)
-	self.addTone(&d)
-	self.addFastingAdjustments(&d)
-
 	return &d
 }
 
-func (self *DayFactory) addCommemorations(ctx context.Context, day *Day) {
-	var rows *sql.Rows
-	var e error
+func (self *DayFactory) addCommemorations(ctx context.Context, days []*Day) error {
+	var pdists []int
+	var dates []MonthDay
 
-	floatIndex := day.pyear.LookupFloatIndex(day.PDist)
-
-	if floatIndex != 0 && floatIndex != 499 {
-		rows, e = self.db.QueryContext(ctx,
-			`select title, subtitle, feast_name, feast_level, service_note, saint, fast, fast_exception
-			from days
-			where pdist = $1 or pdist = $2
-			or (month = $3 and day = $4)`, day.PDist, floatIndex, day.Month, day.Day)
-	} else {
-		rows, e = self.db.QueryContext(ctx,
-			`select title, subtitle, feast_name, feast_level, service_note, saint, fast, fast_exception
-			from days
-			where pdist = $1
-			or (month = $3 and day = $4)`, day.PDist, day.Month, day.Day)
+	floatIndexes := make([]int, len(days))
+	for i, day := range days {
+		floatIndexes[i] = day.pyear.LookupFloatIndex(day.PDist)
+		pdists = append(pdists, day.PDist)
+		if floatIndexes[i] != 0 && floatIndexes[i] != 499 {
+			pdists = append(pdists, floatIndexes[i])
+		}
+		dates = append(dates, MonthDay{day.Month, day.Day})
 	}
 
+	records, e := self.store.Commemorations(ctx, uniqueInts(pdists), dates)
 	if e != nil {
-		log.Printf("Got error querying the database: %#v.", e)
-		return
+		return e
 	}
-	defer rows.Close()
 
-	overallFastLevel, overallFastException, overallFeastLevel := 0, 0, -2
-	for rows.Next() {
-		var title, subtitle, feastName, serviceNote, saint string
-		var feastLevel, fast, fastException int
+	for i, day := range days {
+		overallFastLevel, overallFastException, overallFeastLevel := 0, 0, -2
+
+		// Movable commemorations come first, followed by floats and then
+		// fixed commemorations.
+		var movable, floats, fixed []CommemorationRecord
+		for _, r := range records {
+			switch {
+			case r.PDist == day.PDist:
+				movable = append(movable, r)
+			case floatIndexes[i] != 0 && floatIndexes[i] != 499 && r.PDist == floatIndexes[i]:
+				floats = append(floats, r)
+			case r.Month == day.Month && r.Day == day.Day:
+				fixed = append(fixed, r)
+			}
+		}
 
-		rows.Scan(&title, &subtitle, &feastName, &feastLevel, &serviceNote, &saint, &fast, &fastException)
+		matches := append(append(movable, floats...), fixed...)
+		for _, r := range matches {
+			if title := recordTitle(r); len(title) > 0 {
+				day.Titles = append(day.Titles, title)
+			}
+			if len(r.Saint) > 0 {
+				day.Saints = append(day.Saints, r.Saint)
+			}
+			if len(r.FeastName) > 0 {
+				day.Feasts = append(day.Feasts, r.FeastName)
+			}
+			if len(r.ServiceNote) > 0 {
+				day.ServiceNotes = append(day.ServiceNotes, r.ServiceNote)
+			}
+			day.Commemorations = append(day.Commemorations, newCommemorations(r)...)
 
-		if len(subtitle) > 0 {
-			title = fmt.Sprintf("%s: %s", title, subtitle)
+			// Composite values
+			if r.FeastLevel > overallFeastLevel {
+				overallFeastLevel = r.FeastLevel
+			}
+			if r.Fast > overallFastLevel {
+				overallFastLevel = r.Fast
+			}
+			if r.FastException > overallFastException {
+				overallFastException = r.FastException
+			}
 		}
 
-		if len(title) > 0 {
-			day.Titles = append(day.Titles, title)
-		}
-		if len(saint) > 0 {
-			day.Saints = append(day.Saints, saint)
-		}
-		if len(feastName) > 0 {
-			day.Feasts = append(day.Feasts, feastName)
-		}
-		if len(serviceNote) > 0 {
-			day.ServiceNotes = append(day.ServiceNotes, serviceNote)
-		}
+		day.FastLevel = overallFastLevel
+		day.FastLevelDesc = FastLevels[overallFastLevel]
+		day.FastException = overallFastException
+		day.FastExceptionDesc = FastExceptions[overallFastException]
+		day.FeastLevel = overallFeastLevel
+		day.FeastLevelDesc = FeastLevels[overallFeastLevel]
 
-		// Composite values
-		if feastLevel > overallFeastLevel {
-			overallFeastLevel = feastLevel
-		}
-		if fast > overallFastLevel {
-			overallFastLevel = fast
-		}
-		if fastException > overallFastException {
-			overallFastException = fastException
-		}
+		day.rankCommemorations()
 	}
 
-	day.FastLevel = overallFastLevel
-	day.FastLevelDesc = FastLevels[overallFastLevel]
-	day.FastException = overallFastException
-	day.FastExceptionDesc = FastExceptions[overallFastException]
-	day.FeastLevel = overallFeastLevel
-	day.FeastLevelDesc = FeastLevels[overallFeastLevel]
+	return nil
 }
 
-func (self *DayFactory) addReadings(ctx context.Context, day *Day, bible Bible) {
-	var conditionals []string
-
-	ePDist, gPDist := self.getAdjustedPDists(day)
+// Build the commemorations of a record. A record may name both a feast and a
+// saint, in which case the feast level belongs to the feast and the saint is
+// a lesser commemoration.
+//
+// The great feast of a record is sometimes only its title, as for Palm Sunday,
+// which has no feast name, and Pascha, whose feast name marks the beginning
+// of the Pentecostarion. The title is then the feast and a feast name is a
+// lesser commemoration, so that the feast ranks first.
+func newCommemorations(r CommemorationRecord) []Commemoration {
+	var commemorations []Commemoration
+
+	c := Commemoration{
+		FeastLevel: r.FeastLevel,
+		Movable:    r.Month == 0,
+		title:      recordTitle(r),
+	}
+	if c.Movable {
+		c.PDist = r.PDist
+	} else {
+		c.Month, c.Day = r.Month, r.Day
+	}
 
-	var departed string
-	if day.HasNoMemorial() {
-		departed = "and r.desc != 'Departed'"
+	if len(r.Title) > 0 && r.FeastLevel >= 7 && (len(r.FeastName) == 0 || strings.HasPrefix(r.FeastName, "Beginning of ")) {
+		c.Name = r.Title
+		c.FeastLevelDesc = FeastLevels[c.FeastLevel]
+		commemorations = append(commemorations, c)
+		c.FeastLevel = 0
 	}
+	if len(r.FeastName) > 0 {
+		c.Name = r.FeastName
+		c.FeastLevelDesc = FeastLevels[c.FeastLevel]
+		commemorations = append(commemorations, c)
+		c.FeastLevel = 0
+	}
+	if len(r.Saint) > 0 {
+		c.Name = r.Saint
+		c.FeastLevelDesc = FeastLevels[c.FeastLevel]
+		commemorations = append(commemorations, c)
+	}
+
+	return commemorations
+}
 
-	// Conditional for floats
-	floatIndex := day.pyear.LookupFloatIndex(day.PDist)
-	if floatIndex != 499 {
-		conditionals = append(conditionals, fmt.Sprintf("or (pdist = %d)", floatIndex))
+// The title of the day that a record gives, if any
+func recordTitle(r CommemorationRecord) string {
+	if len(r.Subtitle) > 0 {
+		return fmt.Sprintf("%s: %s", r.Title, r.Subtitle)
 	}
 
-	// Conditional for Matins Gospel
-	hasMatinsGospel, matinsGospel := self.matinsGospel(day)
-	if matinsGospel != 0 {
-		conditionals = append(conditionals, fmt.Sprintf("or (pdist = %d)", matinsGospel+700))
+	return r.Title
+}
+
+func (self *DayFactory) addReadings(ctx context.Context, days []*Day) error {
+	var pdists []int
+	var dates []MonthDay
+
+	selectors := make([]readingSelector, len(days))
+	for i, day := range days {
+		selectors[i] = self.newReadingSelector(day)
+		pdists = append(pdists, selectors[i].pdists()...)
+		dates = append(dates, selectors[i].dates()...)
 	}
 
-	// Conditional for Paremias
-	if day.pyear.HasParemias(day.PDist) {
-		date := time.Date(day.Year, time.Month(day.Month), day.Day+1, 0, 0, 0, 0, time.Local)
-		paremias := fmt.Sprintf("or (r.month = %d and r.day = %d and source = 'Vespers')", date.Month(), date.Day())
-		conditionals = append(conditionals, paremias)
+	records, e := self.store.Readings(ctx, uniqueInts(pdists), uniqueDates(dates))
+	if e != nil {
+		return e
 	}
 
-	// Build Conditional for Month/Day (i.e. non-pdist)
-	var m string
-	if !hasMatinsGospel {
-		m = "and r.source != 'Matins Gospel'"
+	// Fetch the pericopes for the readings
+	var keys []PericopeKey
+	seen := make(map[PericopeKey]bool)
+	for _, r := range records {
+		key := PericopeKey{r.Book, r.Pericope}
+		if !seen[key] {
+			keys = append(keys, key)
+			seen[key] = true
+		}
 	}
-	var p string
-	if day.pyear.HasNoParemias(day.PDist) {
-		p = "and r.source != 'Vespers'"
+
+	pericopeRecords, e := self.store.Pericopes(ctx, keys)
+	if e != nil {
+		return e
 	}
-	var a string
-	if day.Month == 3 && day.Day == 26 && (day.Weekday == Monday || day.Weekday == Tuesday || day.Weekday == Thursday) {
-		// no readings for leavetaking annunciation on non-liturgy day
-		a = "and r.desc != 'Theotokos'"
+
+	pericopes := make(map[PericopeKey]PericopeRecord)
+	for _, p := range pericopeRecords {
+		pericopes[p.PericopeKey] = p
 	}
-	dates := fmt.Sprintf("or (r.month = %d and r.day = %d %s %s %s)", day.Month, day.Day, m, p, a)
-	conditionals = append(conditionals, dates)
 
-	// TODO: Handle arbitrary exceptions
+	for i, day := range days {
+		var matches []rankedReading
 
-	// Since no user provided strings are being used, it is safe to use
-	// string interpolation to build the SQL.
-	query := `
-		select source, r.desc, p.book, display, sdisplay
-		from readings r left join pericopes p
-		on (r.book=p.book and r.pericope=p.pericope)
-		where
-			   (pdist = %d and source = 'Gospel' %s)
-			or (pdist = %d and source = 'Epistle' %s)
-			or (pdist = %d and source != 'Epistle' and source != 'Gospel')
-			%s
-		order by ordering`
+		for _, r := range records {
+			if rank := selectors[i].match(r); rank >= 0 {
+				matches = append(matches, rankedReading{r, rank})
+			}
+		}
 
-	query = fmt.Sprintf(query, gPDist, departed, ePDist, departed, day.PDist, strings.Join(conditionals, " "))
+		// Readings are ordered by their ordering value. Ties are broken by
+		// the kind of match, in the order of the cases in match.
+		sort.SliceStable(matches, func(i, j int) bool {
+			if matches[i].Ordering != matches[j].Ordering {
+				return matches[i].Ordering < matches[j].Ordering
+			}
+			return matches[i].rank < matches[j].rank
+		})
+
+		for _, r := range matches {
+			p := pericopes[PericopeKey{r.Book, r.Pericope}]
+			reading := Reading{
+				Source:              r.Source,
+				Book:                r.Book,
+				Description:         r.Description,
+				Display:             p.Display,
+				ShortDisplay:        p.ShortDisplay,
+				Pericope:            r.Pericope,
+				Pericopes:           splitPericope(r.Pericope),
+				PericopeDescription: p.Description,
+				Ordering:            r.Ordering,
+				pericope:            p,
+				evening:             r.Source == "Vespers" && selectors[i].isEvening(r.rank),
+				fixed:               r.Month != 0,
+			}
 
-	rows, e := self.db.QueryContext(ctx, query)
-	if e != nil {
-		log.Printf("Got error querying the database: %#v.", e)
-		return
-	}
-	defer rows.Close()
-
-	// Fetch all the readings
-	for rows.Next() {
-		var reading Reading
-		rows.Scan(&reading.Source, &reading.Description, &reading.Book, &reading.Display, &reading.ShortDisplay)
-		if bible != nil {
-			// Check for a composite reading
-			groups := compositeRe.FindStringSubmatch(reading.Display)
-			if len(groups) > 1 {
-				num, _ := strconv.Atoi(groups[1])
-				reading.Passage = self.LookupComposite(num)
+			// Label transferred readings with the day they belong to
+			if selectors[i].isTransfer(r.rank) && len(reading.Description) == 0 {
+				reading.Description = time.Weekday(WeekDayFromPDist(selectors[i].transferred)).String()
 			}
 
-			// If there is no composite, lookup the scripture reference
-			if len(reading.Passage) == 0 {
-				passage := bible.Lookup(reading.ShortDisplay)
-				if passage != nil {
-					reading.Passage = passage
+			day.Readings = append(day.Readings, reading)
+		}
+		day.ServiceNotes = append(day.ServiceNotes, selectors[i].serviceNotes()...)
+	}
+
+	return nil
+}
+
+// The number of passages that are looked up at once by default. A Bible need
+// not be safe for concurrent use unless the caller asks for more workers.
+const defaultScriptureWorkers = 1
+
+// Look up the scripture for each of the readings. The passages are looked up
+// concurrently by the factory's workers and the first error stops the rest.
+func (self *DayFactory) addScriptures(ctx context.Context, readings []*Reading, bible Bible) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	queue := make(chan *Reading)
+	errs := make(chan error, self.scriptureWorkers)
+	var wg sync.WaitGroup
+
+	for i := 0; i < self.scriptureWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for reading := range queue {
+				if e := self.addScripture(ctx, reading, bible); e != nil {
+					errs <- e
+					cancel()
+					return
 				}
 			}
+		}()
+	}
+
+	// Each reading is written by only one worker
+feed:
+	for _, reading := range readings {
+		select {
+		case queue <- reading:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	select {
+	case e := <-errs:
+		return e
+	default:
+		return ctx.Err()
+	}
+}
+
+// Look up the scripture for a reading.
+func (self *DayFactory) addScripture(ctx context.Context, reading *Reading, bible Bible) error {
+	// Check for a composite reading
+	groups := compositeRe.FindStringSubmatch(reading.Display)
+	if len(groups) > 1 {
+		var e error
+		num, _ := strconv.Atoi(groups[1])
+		reading.Passage, reading.Translation, e = self.compositePassage(ctx, num, bible)
+		if e != nil {
+			return e
+		}
+	}
+
+	// If there is no composite, lookup the scripture reference
+	if len(reading.Passage) == 0 {
+		passage, translation := lookupPassage(ctx, bible, reading.ShortDisplay, reading.pericope.Verses)
+		if e := ctx.Err(); e != nil {
+			// The passage may be incomplete
+			return e
 		}
-		day.Readings = append(day.Readings, reading)
-	}
-
-	// Move Lenten Matins Gospel to the top
-	if day.PDist > -42 && day.PDist < -7 && day.FeastLevel < 7 {
-		for i, reading := range day.Readings {
-			if reading.Source == "Matins Gospel" {
-				// Remove the matins gospel from the slice
-				x := append(day.Readings[:i], day.Readings[i+1:]...)
-				// prepend the matins gospel to the slice
-				day.Readings = append([]Reading{reading}, x...)
-				break
+		if passage != nil {
+			reading.Passage = passage
+			reading.Translation = translation
+			if !self.rawScripture {
+				reading.Passage = applyIncipit(passage, reading.pericope)
 			}
 		}
 	}
+
+	return nil
 }
 
-func (self *DayFactory) matinsGospel(day *Day) (bool, int) {
-	if day.Weekday == Sunday {
-		if day.PDist > -8 && day.PDist < 50 {
-			return false, 0
-		} else if day.FeastLevel < 7 {
-			pbase := day.PDist
-			if pbase < 0 {
-				pbase = day.JDN - day.pyear.PreviousPascha
-			}
+// The Sunday Matins Gospel of the cycle of 11 or 0 if there is none. The
+// exceptions decide whether it or the Matins Gospel of a feast is read.
+func matinsGospel(day *Day) int {
+	if day.Weekday != Sunday || (day.PDist > -8 && day.PDist < 50) {
+		return 0
+	}
 
-			x := (pbase - 49) % 77
-			if x == 0 {
-				x = 77
-			}
+	pbase := day.PDist
+	if pbase < 0 {
+		pbase = day.JDN - day.pyear.PreviousPascha
+	}
 
-			return false, x / 7
-		}
+	x := (pbase - 49) % 77
+	if x == 0 {
+		x = 77
 	}
 
-	return true, 0
+	return x / 7
 }
 
 func (self *DayFactory) addTone(day *Day) {
@@ -344,46 +699,55 @@ func (self *DayFactory) addTone(day *Day) {
 }
 
 func (self *DayFactory) getAdjustedPDists(day *Day) (ePDist, gPDist int) {
+	// Compute the adjusted pdists for epistle and gospel
+	if day.pyear.HasNoDailyReadings(day.PDist) {
+		gPDist, ePDist = 499, 499
+	} else {
+		ePDist, gPDist = self.dailyPDists(day.pyear, day.PDist)
+	}
+
+	return ePDist, gPDist
+}
+
+// Compute the adjusted pdists of the daily epistle and gospel for the given
+// day whether or not they are suppressed.
+func (self *DayFactory) dailyPDists(pyear *Year, pdist int) (ePDist, gPDist int) {
 	var jump int
 
 	// Compute the Lucan jump
-	_, _, _, sunAfter := SurroundingWeekends(day.pyear.Elevation)
-	if self.doJump && day.PDist > sunAfter {
-		jump = day.pyear.LucanJump
+	_, _, _, sunAfter := SurroundingWeekends(pyear.Elevation)
+	if self.doJump && pdist > sunAfter {
+		jump = pyear.LucanJump
 	}
 
-	// Compute the adjusted pdists for epistle and gospel
-	if day.pyear.HasNoDailyReadings(day.PDist) {
-		gPDist, ePDist = 499, 499
+	jdn := pyear.Pascha + pdist
+	limit := 272
+
+	// Compute adjusted pdist for the epistle
+	if pdist == 252 {
+		ePDist = pyear.Forefathers
+	} else if pdist > limit {
+		ePDist = jdn - pyear.NextPascha
 	} else {
-		limit := 272
-
-		// Compute adjusted pdist for the epistle
-		if day.PDist == 252 {
-			ePDist = day.pyear.Forefathers
-		} else if day.PDist > limit {
-			ePDist = day.JDN - day.pyear.NextPascha
-		} else {
-			ePDist = day.PDist
-		}
+		ePDist = pdist
+	}
 
-		if WeekDayFromPDist(day.pyear.Theophany) < Tuesday {
-			limit = 279
-		}
+	if WeekDayFromPDist(pyear.Theophany) < Tuesday {
+		limit = 279
+	}
 
-		// Compute adjusted pdist for the Gospel
-		_, _, _, sunAfter := SurroundingWeekends(day.pyear.Theophany)
-		if day.PDist == 245-day.pyear.LucanJump {
-			gPDist = day.pyear.Forefathers + day.pyear.LucanJump
-		} else if day.PDist > sunAfter && day.Weekday == Sunday && day.pyear.ExtraSundays > 1 {
-			i := (day.PDist - sunAfter) / 7
-			gPDist = day.pyear.Reserves[i-1]
-		} else if day.PDist+jump > limit {
-			// Theophany stepback
-			gPDist = day.JDN - day.pyear.NextPascha
-		} else {
-			gPDist = day.PDist + jump
-		}
+	// Compute adjusted pdist for the Gospel
+	_, _, _, sunAfter = SurroundingWeekends(pyear.Theophany)
+	if pdist == 245-pyear.LucanJump {
+		gPDist = pyear.Forefathers + pyear.LucanJump
+	} else if pdist > sunAfter && WeekDayFromPDist(pdist) == Sunday && pyear.ExtraSundays > 1 {
+		i := (pdist - sunAfter) / 7
+		gPDist = pyear.Reserves[i-1]
+	} else if pdist+jump > limit {
+		// Theophany stepback
+		gPDist = jdn - pyear.NextPascha
+	} else {
+		gPDist = pdist + jump
 	}
 
 	return ePDist, gPDist
@@ -446,24 +810,98 @@ func (self *DayFactory) addFastingAdjustments(day *Day) {
 	day.FastExceptionDesc = FastExceptions[day.FastException]
 }
 
-func (self *DayFactory) LookupComposite(num int) (passage Passage) {
-	var verse Verse
+// LookupComposite is like LookupCompositeWithContext except that errors are
+// logged rather than returned.
+func (self *DayFactory) LookupComposite(num int) Passage {
+	passage, e := self.LookupCompositeWithContext(context.Background(), num)
+	if e != nil {
+		log.Printf("Got error looking up composite %d: %#v.", num, e)
+	}
+
+	return passage
+}
 
-	sql := "select reading from composites where composite_num = ?"
+// LookupCompositeWithContext returns the fixed translation of a composite
+// reading. The passage is empty if the composite has no fixed translation.
+func (self *DayFactory) LookupCompositeWithContext(ctx context.Context, num int) (Passage, error) {
+	passage, _, e := self.compositePassage(ctx, num, nil)
+	return passage, e
+}
 
-	rows, e := self.db.Query(sql, num)
+// Build the passage of a composite reading from its fixed translation or, if
+// it has none, by looking up its references in the bible. The fixed
+// translation is used as it is, even where it adds or leaves out a verse of
+// the references, which follow the lectionary. References without verses are
+// skipped since the verses that are read are not known. The name of the
+// translation is that of the first reference that is found.
+func (self *DayFactory) compositePassage(ctx context.Context, num int, bible Bible) (Passage, string, error) {
+	var passage Passage
+	var translation string
+
+	composite, e := self.store.Composite(ctx, num)
 	if e != nil {
-		log.Printf("Got error querying the database for composite %d: %#v.", num, e)
-		return passage
+		return passage, translation, e
 	}
-	defer rows.Close()
 
-	if rows.Next() {
-		rows.Scan(&verse.Content)
-		verse.Chapter = 1
-		verse.Verse = 1
-		passage = append(passage, verse)
+	if len(composite.Verses) > 0 {
+		return append(passage, composite.Verses...), CompositeTranslation, nil
 	}
 
-	return passage
+	if bible == nil {
+		return passage, translation, nil
+	}
+
+	for _, ref := range composite.References {
+		if ref.StartVerse == 0 {
+			continue
+		}
+
+		verses, name := lookupPassage(ctx, bible, ref.String(), "")
+		if e := ctx.Err(); e != nil {
+			return nil, "", e
+		}
+		if len(passage) == 0 {
+			translation = name
+		}
+		passage = append(passage, verses...)
+	}
+
+	return passage, translation, nil
+}
+
+// Split a pericope key that joins several pericopes read together, e.g. 54|58.
+func splitPericope(pericope string) []string {
+	if len(pericope) == 0 {
+		return nil
+	}
+
+	return strings.Split(pericope, "|")
+}
+
+func uniqueInts(values []int) []int {
+	var unique []int
+
+	seen := make(map[int]bool)
+	for _, v := range values {
+		if !seen[v] {
+			unique = append(unique, v)
+			seen[v] = true
+		}
+	}
+
+	return unique
+}
+
+func uniqueDates(values []MonthDay) []MonthDay {
+	var unique []MonthDay
+
+	seen := make(map[MonthDay]bool)
+	for _, v := range values {
+		if !seen[v] {
+			unique = append(unique, v)
+			seen[v] = true
+		}
+	}
+
+	return unique
 }
@@ -1,28 +1,101 @@
 package orthocal_test
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	// "encoding/json"
-	"github.com/brianglass/english_bible"
 	"github.com/brianglass/orthocal"
 	_ "github.com/mattn/go-sqlite3"
-	"go/build"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
-	// "time"
+	"time"
 )
 
+// slowBible simulates a remote Bible that takes a while to respond. It
+// counts the lookups and the most that were in flight at once.
+type slowBible struct {
+	delay       time.Duration
+	lookups     int32
+	inFlight    int32
+	maxInFlight int32
+}
+
+func (self *slowBible) Lookup(reference string) orthocal.Passage {
+	return self.LookupWithContext(context.Background(), reference)
+}
+
+func (self *slowBible) LookupWithContext(ctx context.Context, reference string) orthocal.Passage {
+	atomic.AddInt32(&self.lookups, 1)
+
+	n := atomic.AddInt32(&self.inFlight, 1)
+	defer atomic.AddInt32(&self.inFlight, -1)
+	for {
+		most := atomic.LoadInt32(&self.maxInFlight)
+		if n <= most || atomic.CompareAndSwapInt32(&self.maxInFlight, most, n) {
+			break
+		}
+	}
+
+	select {
+	case <-time.After(self.delay):
+		return orthocal.Passage{{Content: reference}}
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// countingStore counts the calls made to the underlying store.
+type countingStore struct {
+	orthocal.CalendarStore
+	commemorations, readings int
+}
+
+func (self *countingStore) Commemorations(ctx context.Context, pdists []int, dates []orthocal.MonthDay) ([]orthocal.CommemorationRecord, error) {
+	self.commemorations++
+	return self.CalendarStore.Commemorations(ctx, pdists, dates)
+}
+
+func (self *countingStore) Readings(ctx context.Context, pdists []int, dates []orthocal.MonthDay) ([]orthocal.ReadingRecord, error) {
+	self.readings++
+	return self.CalendarStore.Readings(ctx, pdists, dates)
+}
+
+// failingStore fails every query.
+type failingStore struct {
+	orthocal.CalendarStore
+}
+
+func (self failingStore) Commemorations(ctx context.Context, pdists []int, dates []orthocal.MonthDay) ([]orthocal.CommemorationRecord, error) {
+	return nil, errors.New("store is down")
+}
+
+// referenceStore drops the fixed translations of composite readings.
+type referenceStore struct {
+	orthocal.CalendarStore
+}
+
+func (self referenceStore) Composite(ctx context.Context, num int) (orthocal.CompositeRecord, error) {
+	record, e := self.CalendarStore.Composite(ctx, num)
+	record.Verses = nil
+	return record, e
+}
+
 func TestDay(t *testing.T) {
 	db, e := sql.Open("sqlite3", "oca_calendar.db")
 	if e != nil {
 		t.Errorf("Got error opening database: %#v.", e)
 	}
 
-	biblePath := build.Default.GOPATH + "/src/github.com/brianglass/english_bible/bible.db"
-	bibledb, e := sql.Open("sqlite3", biblePath)
+	bible, e := orthocal.LoadBibleFiles(orthocal.VersificationMT, "testdata/kjv")
 	if e != nil {
-		t.Errorf("Got error opening database: %#v.", e)
+		t.Fatalf("Got error loading bible: %#v.", e)
 	}
-	bible := english_bible.NewBible(bibledb)
 
 	factory := orthocal.NewDayFactory(false, true, db)
 
@@ -88,6 +161,209 @@ func TestDay(t *testing.T) {
 		}
 	})
 
+	t.Run("Annunciation Leavetaking", func(t *testing.T) {
+		// No readings for the leavetaking on a day without liturgy
+		day := factory.NewDay(2019, 3, 26, nil)
+
+		for _, r := range day.Readings {
+			if r.Description == "Theotokos" {
+				t.Errorf("3/26/2019 should not have readings for the Theotokos but has %s.", r.ShortDisplay)
+			}
+		}
+	})
+
+	t.Run("Commemorations", func(t *testing.T) {
+		day := factory.NewDay(2018, 12, 26, nil)
+
+		expected := []orthocal.Commemoration{
+			{
+				Name:           "Synaxis of the Most-Holy Theotokos",
+				FeastLevel:     3,
+				FeastLevelDesc: orthocal.FeastLevels[3],
+				Primary:        true,
+				Month:          12,
+				Day:            26,
+			},
+			{
+				Name:           "Hieromartyr Euthymius of Sardis",
+				FeastLevel:     0,
+				FeastLevelDesc: orthocal.FeastLevels[0],
+				Month:          12,
+				Day:            26,
+			},
+		}
+
+		for i, c := range expected {
+			if len(day.Commemorations) <= i || day.Commemorations[i] != c {
+				t.Errorf("12/26/2018 commemoration %d should be %#v but got %#v", i, c, day.Commemorations)
+			}
+		}
+	})
+
+	t.Run("Pericopes", func(t *testing.T) {
+		// Nativity of the Theotokos
+		day := factory.NewDay(2018, 9, 8, nil)
+
+		found := false
+		for _, r := range day.Readings {
+			if r.Source == "Gospel" && r.Book == "Luke" {
+				found = true
+				if r.Pericope != "54|58" || !reflect.DeepEqual(r.Pericopes, []string{"54", "58"}) {
+					t.Errorf("9/8/2018's Gospel should be Luke 54 and 58 but got %#v.", r)
+				}
+				if r.PericopeDescription != "Theotokos" || r.Ordering != 901 {
+					t.Errorf("9/8/2018's Gospel has incorrect pericope metadata: %#v.", r)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("9/8/2018 should have a Gospel from Luke but doesn't.")
+		}
+	})
+
+	t.Run("Movable Commemorations", func(t *testing.T) {
+		// Pascha
+		day := factory.NewDay(2018, 4, 8, nil)
+
+		primary := day.Commemorations[0]
+		if primary.Name != "Holy Pascha" || !primary.Primary || !primary.Movable || primary.PDist != 0 || primary.Month != 0 {
+			t.Errorf("The primary commemoration of Pascha should be the movable feast of Pascha but got %#v", primary)
+		}
+		if c := day.Commemorations[1]; c.Name != "Beginning of the Pentecostarion" || !c.Movable {
+			t.Errorf("The Beginning of the Pentecostarion should follow Pascha but got %#v", c)
+		}
+
+		for _, c := range day.Commemorations[1:] {
+			if c.Primary {
+				t.Errorf("Only one commemoration should be primary but %#v is too", c)
+			}
+			if c.FeastLevel > primary.FeastLevel {
+				t.Errorf("%#v should not follow the lower ranked %#v", c, primary)
+			}
+		}
+	})
+
+	t.Run("Primary Feasts", func(t *testing.T) {
+		// The great feasts are the primary commemorations of their days
+		tests := []struct {
+			month, day int
+			feast      string
+		}{
+			{3, 25, "Annunciation Most Holy Theotokos"},
+			{4, 1, "Entrance of Our Lord into Jerusalem"},
+			{4, 8, "Holy Pascha"},
+			{5, 17, "Ascension of the Lord"},
+			{5, 27, "Holy Pentecost"},
+		}
+
+		for _, test := range tests {
+			day := factory.NewDay(2018, test.month, test.day, nil)
+			if len(day.Commemorations) == 0 || day.Commemorations[0].Name != test.feast || day.Commemorations[0].FeastLevel < 7 {
+				t.Errorf("%s should be the primary commemoration of %d/%d/2018 but got %#v.", test.feast, test.month, test.day, day.Commemorations)
+			}
+		}
+
+		// The feasts of the day are those of the calendar
+		if feasts := factory.NewDay(2018, 4, 8, nil).Feasts; !reflect.DeepEqual(feasts, []string{"Beginning of the Pentecostarion"}) {
+			t.Errorf("Pascha should have the feasts of the calendar but has %#v.", feasts)
+		}
+		if feasts := factory.NewDay(2018, 4, 1, nil).Feasts; len(feasts) != 0 {
+			t.Errorf("Palm Sunday should have no feasts but has %#v.", feasts)
+		}
+	})
+
+	t.Run("Theophany Transfer", func(t *testing.T) {
+		// Theophany falls on each day of the week in these years
+		for _, year := range []int{2019, 2020, 2026, 2021, 2022, 2023, 2024} {
+			theophany := time.Date(year, time.January, 6, 0, 0, 0, 0, time.UTC)
+			transfer := theophany.Weekday() == time.Sunday
+
+			for d := 1; d <= 6; d++ {
+				day := factory.NewDay(year, 1, d, nil)
+
+				var notes, transferred int
+				for _, note := range day.ServiceNotes {
+					if strings.HasPrefix(note, "The daily readings") {
+						notes++
+					}
+				}
+				for _, r := range day.Readings {
+					if r.Description == "Saturday" {
+						transferred++
+					}
+				}
+
+				switch {
+				case transfer && d == 3:
+					if notes != 1 || transferred != 2 {
+						t.Errorf("1/3/%d should have Saturday's Epistle and Gospel and a note but has %d readings and %d notes.", year, transferred, notes)
+					}
+				case transfer && d == 5:
+					if notes != 1 {
+						t.Errorf("1/5/%d should have a note that its readings are read on Thursday but has %#v.", year, day.ServiceNotes)
+					}
+				default:
+					if notes != 0 || transferred != 0 {
+						t.Errorf("1/%d/%d should not have transferred readings but has %d readings and %d notes.", d, year, transferred, notes)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("Reprepare", func(t *testing.T) {
+		store := orthocal.NewSQLiteStore(db)
+		f := orthocal.NewDayFactoryWithStore(false, true, store)
+
+		before := f.NewDay(2018, 3, 25, nil)
+		store.Close()
+		after := f.NewDay(2018, 3, 25, nil)
+
+		if after == nil || !reflect.DeepEqual(before.Readings, after.Readings) {
+			t.Errorf("The store should prepare its statements again after being closed.")
+		}
+	})
+
+	t.Run("Legacy Database", func(t *testing.T) {
+		// A database built before the composites were divided into verses
+		data, e := os.ReadFile("oca_calendar.db")
+		if e != nil {
+			t.Fatalf("Got error reading database: %#v.", e)
+		}
+		path := filepath.Join(t.TempDir(), "oca_calendar.db")
+		if e := os.WriteFile(path, data, 0644); e != nil {
+			t.Fatalf("Got error copying database: %#v.", e)
+		}
+
+		legacy, e := sql.Open("sqlite3", path)
+		if e != nil {
+			t.Fatalf("Got error opening database: %#v.", e)
+		}
+		defer legacy.Close()
+
+		for _, statement := range []string{
+			"drop table composites",
+			"drop table composite_references",
+			"create table composites (composite_num tinyint default null, reading text default null)",
+			"insert into composites values(1, 'The Lord appeared to Abram.')",
+		} {
+			if _, e := legacy.Exec(statement); e != nil {
+				t.Fatalf("Got error building the old schema: %#v.", e)
+			}
+		}
+
+		f := orthocal.NewDayFactory(false, true, legacy)
+		day, e := f.NewDayWithContext(context.Background(), 2018, 3, 25, nil)
+		if e != nil || len(day.Readings) == 0 {
+			t.Fatalf("Expected 3/25/2018 to build with the old schema but got error %#v.", e)
+		}
+
+		passage, e := f.LookupCompositeWithContext(context.Background(), 1)
+		if e != nil || len(passage) != 1 || passage[0].Content != "The Lord appeared to Abram." {
+			t.Errorf("Expected the text of composite 1 from the old schema but got %#v and error %#v.", passage, e)
+		}
+	})
+
 	t.Run("Scriptures", func(t *testing.T) {
 		// Cheesefare Sunday
 		day := factory.NewDay(2018, 2, 18, bible)
@@ -236,6 +512,374 @@ func TestDay(t *testing.T) {
 		}
 	})
 
+	t.Run("Composite Verses", func(t *testing.T) {
+		passage, e := factory.LookupCompositeWithContext(context.Background(), 22)
+		if e != nil {
+			t.Fatalf("Got error looking up composite 22: %#v.", e)
+		}
+
+		expected := []uint16{1, 4, 8, 9, 10, 11}
+		if len(passage) != len(expected) {
+			t.Fatalf("Composite 22 should have %d verses but has %d.", len(expected), len(passage))
+		}
+		for i, verse := range passage {
+			if verse.Book != "ZEC" || verse.Chapter != 14 || verse.Verse != expected[i] {
+				t.Errorf("Verse %d of composite 22 should be ZEC 14.%d but is %s %d.%d.", i, expected[i], verse.Book, verse.Chapter, verse.Verse)
+			}
+		}
+	})
+
+	t.Run("Composite References", func(t *testing.T) {
+		// Without a fixed translation the references are looked up in the bible
+		f := orthocal.NewDayFactoryWithStore(false, true, referenceStore{orthocal.NewSQLiteStore(db)})
+
+		// Ascension
+		day := f.NewDay(2019, 6, 6, &slowBible{})
+
+		found := false
+		for _, r := range day.Readings {
+			if strings.HasPrefix(r.Display, "Composite 22 ") {
+				found = true
+
+				var refs []string
+				for _, verse := range r.Passage {
+					refs = append(refs, verse.Content)
+				}
+				if !reflect.DeepEqual(refs, []string{"Zech 14.1", "Zech 14.4", "Zech 14.8-11"}) {
+					t.Errorf("Composite 22 should be looked up by its references but got %#v.", refs)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("6/6/2019 should have composite 22 but doesn't.")
+		}
+	})
+
+	t.Run("Composite Consistency", func(t *testing.T) {
+		store := orthocal.NewSQLiteStore(db)
+
+		type verse struct {
+			book           string
+			chapter, verse int
+		}
+
+		// The fixed translation adds or leaves out a verse of the lectionary
+		// here and there. The references follow the lectionary.
+		differences := map[verse]bool{
+			{"GEN", 17, 3}:  true,
+			{"GEN", 21, 3}:  true,
+			{"1KI", 17, 24}: true,
+			{"ISA", 55, 4}:  true,
+			{"ISA", 55, 5}:  true,
+		}
+
+		for num := 1; num <= 24; num++ {
+			composite, e := store.Composite(context.Background(), num)
+			if e != nil {
+				t.Fatalf("Got error looking up composite %d: %#v.", num, e)
+			}
+
+			// The references are those of the pericope's display
+			var display string
+			if e := db.QueryRow("select sdisplay from pericopes where display like ?", fmt.Sprintf("Composite %d - %%", num)).Scan(&display); e != nil {
+				t.Fatalf("Got error looking up the pericope of composite %d: %#v.", num, e)
+			}
+			references, e := orthocal.ParseReference(display)
+			if e != nil {
+				t.Fatalf("Got error parsing %#v: %#v.", display, e)
+			}
+			if len(references) != len(composite.References) {
+				t.Errorf("Composite %d should have the references of %#v but has %v.", num, display, composite.References)
+			} else {
+				for i, r := range composite.References {
+					p := references[i]
+					if r.Book != p.Book || r.StartChapter != p.StartChapter || r.StartVerse != p.StartVerse || r.EndChapter != p.EndChapter || r.EndVerse != p.EndVerse {
+						t.Errorf("Reference %d of composite %d should be %s but is %s.", i+1, num, p, r)
+					}
+				}
+			}
+
+			// Whether the verse is within one of the references
+			within := func(v verse) bool {
+				for _, r := range composite.References {
+					start, end := r.StartVerse, r.EndVerse
+					if start == 0 {
+						end = 999
+					}
+					if r.Book == v.book &&
+						(v.chapter > r.StartChapter || v.chapter == r.StartChapter && v.verse >= start) &&
+						(v.chapter < r.EndChapter || v.chapter == r.EndChapter && v.verse <= end) {
+						return true
+					}
+				}
+				return false
+			}
+
+			rows := make(map[verse]bool)
+			for _, v := range composite.Verses {
+				rows[verse{v.Book, int(v.Chapter), int(v.Verse)}] = true
+				if !within(verse{v.Book, int(v.Chapter), int(v.Verse)}) && !differences[verse{v.Book, int(v.Chapter), int(v.Verse)}] {
+					t.Errorf("%s %d.%d of composite %d is not in its references.", v.Book, v.Chapter, v.Verse, num)
+				}
+			}
+
+			// The text of some composites is not divided into verses
+			if len(composite.Verses) == 0 || composite.Verses[0].Verse == 0 {
+				continue
+			}
+
+			for _, r := range composite.References {
+				// The last verse of a chapter is not known
+				verses := []verse{{r.Book, r.StartChapter, r.StartVerse}, {r.Book, r.EndChapter, r.EndVerse}}
+				if r.StartChapter == r.EndChapter {
+					verses = nil
+					for v := r.StartVerse; v <= r.EndVerse; v++ {
+						verses = append(verses, verse{r.Book, r.StartChapter, v})
+					}
+				}
+
+				for _, v := range verses {
+					if !rows[v] && !differences[v] {
+						t.Errorf("%s %d.%d is in the references of composite %d but has no text.", v.book, v.chapter, v.verse, num)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("Invalid Date", func(t *testing.T) {
+		for _, date := range [][3]int{{2018, 4, 31}, {2019, 2, 29}, {2100, 2, 29}, {2018, 13, 1}, {2018, 0, 1}} {
+			_, e := factory.NewDayWithContext(context.Background(), date[0], date[1], date[2], nil)
+			if !errors.Is(e, orthocal.ErrInvalidDate) {
+				t.Errorf("%d/%d/%d should be an invalid date but got error %#v.", date[1], date[2], date[0], e)
+			}
+		}
+
+		// NewDay still returns the day, wrapped to the next month
+		day := factory.NewDay(2018, 4, 31, nil)
+		if day == nil || day.Month != 5 || day.Day != 1 || day.Year != 2018 || day.Weekday != orthocal.Tuesday {
+			t.Errorf("NewDay for 4/31/2018 should return 5/1/2018 but got %#v.", day)
+		}
+	})
+
+	t.Run("Store Error", func(t *testing.T) {
+		f := orthocal.NewDayFactoryWithStore(false, true, failingStore{})
+
+		// NewDay returns the date even if the store fails
+		day := f.NewDay(2018, 3, 25, nil)
+		if day == nil || day.Month != 3 || day.Day != 25 || day.PDist != -14 || len(day.Readings) != 0 {
+			t.Errorf("NewDay for 3/25/2018 should return only the date but got %#v.", day)
+		}
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		day, e := factory.NewDayWithContext(ctx, 2018, 3, 25, nil)
+		if !errors.Is(e, context.Canceled) {
+			t.Errorf("Expected context.Canceled but got %#v.", e)
+		}
+		if day != nil {
+			t.Errorf("Expected a nil day when the context is canceled.")
+		}
+	})
+
+	t.Run("Slow Bible", func(t *testing.T) {
+		bible := &slowBible{delay: 10 * time.Millisecond}
+
+		// The passages are compared without their incipits
+		factory := orthocal.NewDayFactory(false, true, db)
+		factory.SetRawScripture(true)
+
+		day, e := factory.NewDayWithContext(context.Background(), 2018, 2, 18, bible)
+		if e != nil {
+			t.Fatalf("Got error building 2/18/2018: %#v.", e)
+		}
+		for _, r := range day.Readings {
+			if len(r.Passage) != 1 || r.Passage[0].Content != r.ShortDisplay {
+				t.Errorf("2/18/2018 reading %s should have been looked up with the context.", r.ShortDisplay)
+			}
+		}
+	})
+
+	t.Run("Slow Bible Timeout", func(t *testing.T) {
+		bible := &slowBible{delay: time.Second}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		day, e := factory.NewDayWithContext(ctx, 2018, 3, 25, bible)
+		elapsed := time.Since(start)
+
+		if !errors.Is(e, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded but got %#v.", e)
+		}
+		if day != nil {
+			t.Errorf("Expected a nil day when the deadline is exceeded.")
+		}
+		if elapsed > 500*time.Millisecond {
+			t.Errorf("NewDayWithContext took %s to notice the deadline.", elapsed)
+		}
+		if bible.lookups != 1 {
+			t.Errorf("Expected scripture lookups to stop after the deadline but got %d lookups.", bible.lookups)
+		}
+	})
+
+	t.Run("Parallel Bible", func(t *testing.T) {
+		bible := &slowBible{delay: 50 * time.Millisecond}
+
+		factory := orthocal.NewDayFactory(false, true, db)
+		factory.SetRawScripture(true)
+		factory.SetScriptureWorkers(4)
+
+		// Annunciation and Holy Saturday have many paremias
+		days, e := factory.NewDaysInRange(context.Background(), time.Date(2018, 3, 24, 0, 0, 0, 0, time.UTC), time.Date(2018, 4, 7, 0, 0, 0, 0, time.UTC), bible)
+		if e != nil {
+			t.Fatalf("Got error building days: %#v.", e)
+		}
+
+		for _, day := range days {
+			for _, r := range day.Readings {
+				if strings.HasPrefix(r.Display, "Composite") {
+					continue
+				}
+				if len(r.Passage) != 1 || r.Passage[0].Content != r.ShortDisplay {
+					t.Errorf("%d/%d/%d reading %s has the passage of another reading.", day.Month, day.Day, day.Year, r.ShortDisplay)
+				}
+			}
+		}
+
+		if bible.maxInFlight < 2 || bible.maxInFlight > 4 {
+			t.Errorf("Expected 2 to 4 lookups at once with 4 workers but got %d.", bible.maxInFlight)
+		}
+
+		bible = &slowBible{delay: time.Millisecond}
+		factory.SetScriptureWorkers(1)
+		if _, e := factory.NewDayWithContext(context.Background(), 2018, 4, 7, bible); e != nil {
+			t.Errorf("Got error building 4/7/2018 with one worker: %#v.", e)
+		}
+		if bible.maxInFlight != 1 {
+			t.Errorf("Expected one lookup at a time with one worker but got %d.", bible.maxInFlight)
+		}
+
+		// A factory looks up one passage at a time unless it is told otherwise
+		bible = &slowBible{delay: time.Millisecond}
+		if _, e := orthocal.NewDayFactory(false, true, db).NewDayWithContext(context.Background(), 2018, 4, 7, bible); e != nil {
+			t.Errorf("Got error building 4/7/2018: %#v.", e)
+		}
+		if bible.maxInFlight != 1 {
+			t.Errorf("Expected one lookup at a time by default but got %d.", bible.maxInFlight)
+		}
+	})
+
+	t.Run("Month", func(t *testing.T) {
+		days, e := factory.NewMonth(context.Background(), 2018, 3, nil)
+		if e != nil {
+			t.Fatalf("Got error building March 2018: %#v.", e)
+		}
+
+		if len(days) != 31 {
+			t.Fatalf("March 2018 should have 31 days but has %d.", len(days))
+		}
+
+		for i, day := range days {
+			if day.Month != 3 || day.Day != i+1 {
+				t.Errorf("Day %d of March 2018 is %d/%d/%d.", i+1, day.Month, day.Day, day.Year)
+			}
+
+			single := factory.NewDay(2018, 3, i+1, nil)
+			if !reflect.DeepEqual(day, single) {
+				t.Errorf("3/%d/2018 differs from the single day.", i+1)
+			}
+		}
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		start := time.Date(2018, 12, 30, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2019, 1, 8, 0, 0, 0, 0, time.UTC)
+		days, e := factory.NewDaysInRange(context.Background(), start, end, nil)
+		if e != nil {
+			t.Fatalf("Got error building range: %#v.", e)
+		}
+
+		if len(days) != 10 {
+			t.Fatalf("12/30/2018 through 1/8/2019 should be 10 days but is %d.", len(days))
+		}
+
+		for i, day := range days {
+			date := start.AddDate(0, 0, i)
+			if day.Year != date.Year() || day.Month != int(date.Month()) || day.Day != date.Day() {
+				t.Errorf("Day %d of the range should be %s but is %d/%d/%d.", i, date.Format("1/2/2006"), day.Month, day.Day, day.Year)
+			}
+		}
+
+		_, e = factory.NewDaysInRange(context.Background(), end, start, nil)
+		if !errors.Is(e, orthocal.ErrInvalidDate) {
+			t.Errorf("Expected ErrInvalidDate for a backwards range but got %#v.", e)
+		}
+	})
+
+	t.Run("Year Calendar", func(t *testing.T) {
+		days, e := factory.NewYearCalendar(context.Background(), 2020, nil)
+		if e != nil {
+			t.Fatalf("Got error building 2020: %#v.", e)
+		}
+
+		if len(days) != 366 {
+			t.Fatalf("2020 should have 366 days but has %d.", len(days))
+		}
+
+		// Pascha 2020
+		if day := days[109]; day.Month != 4 || day.Day != 19 || day.PDist != 0 {
+			t.Errorf("Day 110 of 2020 should be Pascha but is %d/%d/%d.", day.Month, day.Day, day.Year)
+		}
+	})
+
+	t.Run("Store", func(t *testing.T) {
+		store := &countingStore{CalendarStore: orthocal.NewSQLiteStore(db)}
+		f := orthocal.NewDayFactoryWithStore(false, true, store)
+
+		days, e := f.NewMonth(context.Background(), 2018, 3, nil)
+		if e != nil {
+			t.Fatalf("Got error building March 2018: %#v.", e)
+		}
+
+		if store.commemorations != 1 || store.readings != 1 {
+			t.Errorf("March 2018 should be fetched with 1 query each but took %d and %d.", store.commemorations, store.readings)
+		}
+
+		if !reflect.DeepEqual(days[24].Feasts, factory.NewDay(2018, 3, 25, nil).Feasts) {
+			t.Errorf("3/25/2018 from the store should match the factory.")
+		}
+	})
+
+	t.Run("Query Error", func(t *testing.T) {
+		closed, _ := sql.Open("sqlite3", "oca_calendar.db")
+		closed.Close()
+
+		_, e := orthocal.NewDayFactory(false, true, closed).NewDayWithContext(context.Background(), 2018, 3, 25, nil)
+		if !errors.Is(e, orthocal.ErrQuery) {
+			t.Errorf("Expected ErrQuery but got %#v.", e)
+		}
+	})
+
+	t.Run("Composite Error", func(t *testing.T) {
+		closed, _ := sql.Open("sqlite3", "oca_calendar.db")
+		closed.Close()
+
+		factory := orthocal.NewDayFactory(false, true, closed)
+		_, e := factory.LookupCompositeWithContext(context.Background(), 2)
+		if !errors.Is(e, orthocal.ErrQuery) {
+			t.Errorf("Expected ErrQuery but got %#v.", e)
+		}
+
+		if passage := factory.LookupComposite(2); len(passage) != 0 {
+			t.Errorf("Expected an empty passage but got %#v.", passage)
+		}
+	})
+
 	/*
 		// today := time.Now()
 		today := time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)
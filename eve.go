@@ -0,0 +1,79 @@
+package orthocal
+
+// Eves
+//
+// The liturgical day begins at Vespers on the evening before. An Eve gathers
+// what is served on the evening of a day for the next day, so that the
+// services of tonight can be shown without building the next Day as well.
+
+// An Eve is the evening of a day, with which the next day begins.
+type Eve struct {
+	// The date of the next day in the calendar of the factory
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+
+	Titles         []string `json:"titles"`
+	Feasts         []string `json:"feasts"`
+	FeastLevel     int      `json:"feast_level"`
+	FeastLevelDesc string   `json:"feast_level_description"`
+	Tone           int      `json:"tone"`
+
+	// Great Vespers is served on the eve of Sundays and of feasts with a
+	// polyeleos or higher.
+	GreatVespers bool `json:"great_vespers"`
+
+	// The fast is kept by the civil day, so the fasting of the next day
+	// applies from tomorrow. FastChange is whether it differs from today's.
+	FastLevel         int    `json:"fast_level"`
+	FastLevelDesc     string `json:"fast_level_desc"`
+	FastException     int    `json:"fast_exception"`
+	FastExceptionDesc string `json:"fast_exception_desc"`
+	FastChange        bool   `json:"fast_change"`
+
+	// The Vespers readings (paremias) of the evening
+	Readings []Reading `json:"readings"`
+}
+
+// The lowest feast level that is served with Great Vespers
+const greatVespersFeastLevel = 4
+
+// Build the eve of day from the next day.
+func newEve(day, next *Day) *Eve {
+	eve := Eve{
+		Year:              next.Year,
+		Month:             next.Month,
+		Day:               next.Day,
+		Titles:            next.Titles,
+		Feasts:            next.Feasts,
+		FeastLevel:        next.FeastLevel,
+		FeastLevelDesc:    next.FeastLevelDesc,
+		Tone:              next.Tone,
+		GreatVespers:      next.Weekday == Sunday || next.FeastLevel >= greatVespersFeastLevel,
+		FastLevel:         next.FastLevel,
+		FastLevelDesc:     next.FastLevelDesc,
+		FastException:     next.FastException,
+		FastExceptionDesc: next.FastExceptionDesc,
+		FastChange:        next.FastLevel != day.FastLevel || next.FastException != day.FastException,
+	}
+
+	// Some days have the Vespers of their own evening. The Vespers readings
+	// of other days are read on the evening before.
+	for _, reading := range day.Readings {
+		if reading.evening {
+			eve.Readings = append(eve.Readings, reading)
+		}
+	}
+	for _, reading := range next.Readings {
+		if reading.isEveOfDay() {
+			eve.Readings = append(eve.Readings, reading)
+		}
+	}
+
+	return &eve
+}
+
+// Whether the reading is read at Vespers on the evening before its day.
+func (self *Reading) isEveOfDay() bool {
+	return self.Source == "Vespers" && !self.evening
+}
@@ -0,0 +1,148 @@
+package orthocal_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"reflect"
+	"testing"
+)
+
+func TestEve(t *testing.T) {
+	db, e := sql.Open("sqlite3", "oca_calendar.db")
+	if e != nil {
+		t.Fatalf("Got error opening database: %#v.", e)
+	}
+
+	factory := orthocal.NewDayFactory(false, true, db)
+	factory.SetEveServices(true)
+
+	readings := func(eve *orthocal.Eve) []string {
+		var references []string
+		for _, r := range eve.Readings {
+			references = append(references, r.ShortDisplay)
+		}
+		return references
+	}
+
+	tests := []struct {
+		name         string
+		month, day   int
+		greatVespers bool
+		fastChange   bool
+		readings     []string
+	}{
+		// The paremias of a feast are read on the evening before
+		{"Nativity of the Theotokos", 9, 7, true, true, []string{"Gen 28.10-17", "Ezek 43.27-44.4", "Prov 9.1-11"}},
+		{"After the Feast", 9, 8, true, true, nil},
+
+		// Vespers on the eve of Nativity is joined to the Liturgy, so the
+		// evening before has no paremias.
+		{"Before the Eve of Nativity", 12, 23, false, true, nil},
+		{"Eve of Nativity", 12, 24, true, true, []string{"Gen 1.1-13", "Num 24.2-3, 5-9, 17-18", "Micah 4.6-7; 5.2-4", "Isa 11.1-10", "Baruch 3.35-4.4", "Daniel 2.31-36, 44-45", "Isa 9.6-7", "Isa 7.10-16; 8.1-4, 9-10"}},
+		{"Synaxis", 12, 25, false, false, nil},
+
+		// Forgiveness Vespers has no paremias but the Lenten weekdays do
+		{"Forgiveness Sunday", 2, 18, false, true, nil},
+		{"Clean Monday", 2, 19, false, false, []string{"Gen 1.1-13", "Prov 1.1-20"}},
+
+		// Vespers of Holy Saturday begins Pascha
+		{"Holy Saturday", 4, 7, true, true, []string{"Gen 1.1-13", "Isa 60.1-16", "Exod 12.1-11", "Jonah 1.1-4.11", "Josh 5.10-15", "Exod 13.20-15.19", "Zeph 3.8-15", "3 Kgs 17.8-24", "Isa 61.10-62.5", "Gen 22.1-18", "Isa 61.1-9", "4 Kgs 4.8-37", "Isa 63.11-64.5", "Jer 31.31-34", "Daniel 3.1-23"}},
+
+		// The feasts of the Paschal cycle have their paremias on the evening
+		// before like the fixed feasts
+		{"Eve of Ascension", 5, 16, true, true, []string{"Isa 2.2-3", "Isa 62.10-63.3, 7-9", "Zech 14.1, 4, 8-11"}},
+		{"Ascension", 5, 17, false, true, nil},
+		{"Eve of Pentecost", 5, 26, true, false, []string{"Num 11.16-17, 24-29", "Joel 2.23-32", "Ezek 36.24-28"}},
+		{"Pentecost", 5, 27, false, true, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			day := factory.NewDay(2018, test.month, test.day, nil)
+			eve := day.Eve
+			if eve == nil {
+				t.Fatalf("%d/%d/2018 should have an eve but doesn't.", test.month, test.day)
+			}
+
+			next := factory.NewDay(2018, test.month, test.day+1, nil)
+			if eve.Month != next.Month || eve.Day != next.Day || !reflect.DeepEqual(eve.Feasts, next.Feasts) {
+				t.Errorf("The eve of %d/%d/2018 should begin %d/%d but begins %d/%d with %#v.", test.month, test.day, next.Month, next.Day, eve.Month, eve.Day, eve.Feasts)
+			}
+
+			if eve.GreatVespers != test.greatVespers {
+				t.Errorf("The eve of %d/%d/2018 should have great vespers %v but has %v.", test.month, test.day, test.greatVespers, eve.GreatVespers)
+			}
+			if eve.FastChange != test.fastChange {
+				t.Errorf("The eve of %d/%d/2018 should have fast change %v but has %v.", test.month, test.day, test.fastChange, eve.FastChange)
+			}
+			if actual := readings(eve); !reflect.DeepEqual(actual, test.readings) {
+				t.Errorf("The eve of %d/%d/2018 should have readings %#v but has %#v.", test.month, test.day, test.readings, actual)
+			}
+		})
+	}
+
+	t.Run("Moved Paremias", func(t *testing.T) {
+		// The paremias of the Finding of the Head of St John on Saturday in
+		// Lent are read on Friday evening.
+		day := factory.NewDay(2018, 2, 23, nil)
+
+		found := false
+		for _, r := range day.Eve.Readings {
+			if r.ShortDisplay == "Isa 40, 41, 45, 48, 54" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("The eve of 2/23/2018 should have the paremias of St John but has %#v.", readings(day.Eve))
+		}
+		if next := factory.NewDay(2018, 2, 24, nil); len(next.Eve.Readings) != 0 {
+			t.Errorf("The eve of 2/24/2018 should have no paremias but has %#v.", readings(next.Eve))
+		}
+	})
+
+	t.Run("Month", func(t *testing.T) {
+		days, e := factory.NewMonth(context.Background(), 2018, 9, nil)
+		if e != nil {
+			t.Fatalf("Got error building September 2018: %#v.", e)
+		}
+
+		for i, day := range days {
+			if !reflect.DeepEqual(day, factory.NewDay(2018, 9, i+1, nil)) {
+				t.Errorf("9/%d/2018 is different when built as part of the month.", i+1)
+			}
+		}
+		if days[len(days)-1].Eve.Month != 10 {
+			t.Errorf("The eve of the last day of the month should begin October.")
+		}
+	})
+
+	t.Run("Scripture", func(t *testing.T) {
+		bible := &mtBible{}
+		day := factory.NewDay(2018, 5, 16, bible)
+
+		for _, r := range day.Eve.Readings {
+			if len(r.Passage) == 0 {
+				t.Errorf("The eve reading %s should have its passage.", r.ShortDisplay)
+			}
+		}
+
+		// The rest of the next day is not looked up
+		looked := make(map[string]bool)
+		for _, reference := range bible.references {
+			looked[reference] = true
+		}
+		for _, r := range factory.NewDay(2018, 5, 17, nil).Readings {
+			if r.Source != "Vespers" && looked[r.ShortDisplay] {
+				t.Errorf("%s of 5/17/2018 should not be looked up for 5/16/2018.", r.ShortDisplay)
+			}
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		if day := orthocal.NewDayFactory(false, true, db).NewDay(2018, 9, 7, nil); day.Eve != nil {
+			t.Errorf("Days should not have eves unless the factory includes them.")
+		}
+	})
+}
@@ -0,0 +1,565 @@
+package orthocal
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Exceptions
+//
+// Exceptions are declarative rules that change the readings and
+// commemorations of the days they match. They are applied after the readings
+// and commemorations have been selected from the store, so they can encode
+// local typikon decisions without changes to the code.
+
+var ErrInvalidException = errors.New("orthocal: invalid exception")
+
+// Exception actions
+const (
+	ActionAdd     = "add"
+	ActionRemove  = "remove"
+	ActionMove    = "move"
+	ActionReorder = "reorder"
+)
+
+// Exception targets
+const (
+	TargetReadings       = "readings"
+	TargetCommemorations = "commemorations"
+)
+
+//go:embed exceptions.json
+var builtinExceptionsJSON string
+
+// The exceptions that every DayFactory applies
+var builtinExceptions = mustLoadExceptions(builtinExceptionsJSON)
+
+// An Exception is a rule that changes the readings or commemorations of the
+// days that satisfy all of its conditions. A condition that is not set is
+// satisfied by every day. The conditions of a move apply to the day the
+// readings or commemorations are moved from.
+//
+// A day satisfies the pdist condition if its pdist is one of PDists or within
+// one of PDistRanges, and likewise for its feast level. Ranges are inclusive,
+// e.g. [-48, -1] for the days of Lent and Holy Week. A day satisfies
+// MovedParemias if its year moves its paremias to the day before, as listed
+// in Year.NoParemias.
+//
+// A reorder puts the matches first or last. An added commemoration goes after
+// those of its feast level unless the position is first, so that a feast can
+// be made the primary commemoration of its day. An added commemoration that
+// is movable has the pdist of the day rather than its date.
+type Exception struct {
+	Name string `json:"name"`
+
+	// Conditions
+	PDists           []int      `json:"pdists,omitempty"`
+	PDistRanges      [][2]int   `json:"pdist_ranges,omitempty"`
+	Dates            []MonthDay `json:"dates,omitempty"`
+	Weekdays         []int      `json:"weekdays,omitempty"`
+	FeastLevels      []int      `json:"feast_levels,omitempty"`
+	FeastLevelRanges [][2]int   `json:"feast_level_ranges,omitempty"`
+	Feasts           []string   `json:"feasts,omitempty"` // all of these are commemorated on the day
+	MovedParemias    bool       `json:"moved_paremias,omitempty"`
+
+	Action string `json:"action"`
+	Target string `json:"target"`
+
+	// Selects the readings or commemorations that are removed, moved or
+	// reordered. An empty match selects all of them.
+	Match ExceptionMatch `json:"match"`
+
+	// The reading or commemoration that is added
+	Reading       *ExceptionReading `json:"reading,omitempty"`
+	Commemoration *Commemoration    `json:"commemoration,omitempty"`
+	Offset        int               `json:"offset,omitempty"`   // days to move to, negative for earlier days
+	Position      string            `json:"position,omitempty"` // first or last
+	ServiceNote   string            `json:"service_note,omitempty"`
+}
+
+// An ExceptionMatch selects readings by the fields that are set or
+// commemorations by name. The source is a pattern as for path.Match, so that
+// "* Matins Gospel" selects the Sunday Matins Gospels of the cycle of eleven
+// but not the Matins Gospel of a feast. The calendar is fixed for readings of
+// the fixed calendar and movable for the rest.
+type ExceptionMatch struct {
+	Source       string `json:"source,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Book         string `json:"book,omitempty"`
+	ShortDisplay string `json:"short_display,omitempty"`
+	Calendar     string `json:"calendar,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// Calendars of ExceptionMatch
+const (
+	CalendarFixed   = "fixed"
+	CalendarMovable = "movable"
+)
+
+// An ExceptionReading is a reading added by an exception. The pericope is
+// looked up in the store. The reading goes among the readings of the day by
+// its ordering, or after them if it has none.
+type ExceptionReading struct {
+	Source      string `json:"source"`
+	Description string `json:"description"`
+	Book        string `json:"book"`
+	Pericope    string `json:"pericope"`
+	Ordering    int    `json:"ordering,omitempty"`
+}
+
+// LoadExceptions reads a JSON array of exceptions and validates them.
+func LoadExceptions(r io.Reader) ([]Exception, error) {
+	var exceptions []Exception
+
+	if e := json.NewDecoder(r).Decode(&exceptions); e != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidException, e)
+	}
+
+	for i := range exceptions {
+		if e := exceptions[i].validate(); e != nil {
+			return nil, fmt.Errorf("%w: exception %d (%#v): %s", ErrInvalidException, i+1, exceptions[i].Name, e)
+		}
+	}
+
+	return exceptions, nil
+}
+
+func mustLoadExceptions(data string) []Exception {
+	exceptions, e := LoadExceptions(strings.NewReader(data))
+	if e != nil {
+		panic(e)
+	}
+
+	return exceptions
+}
+
+func (self *Exception) validate() error {
+	if self.Target != TargetReadings && self.Target != TargetCommemorations {
+		return fmt.Errorf("unknown target %#v", self.Target)
+	}
+
+	for _, ranges := range [][][2]int{self.PDistRanges, self.FeastLevelRanges} {
+		for _, r := range ranges {
+			if r[1] < r[0] {
+				return fmt.Errorf("range %v ends before it starts", r)
+			}
+		}
+	}
+
+	if _, e := path.Match(self.Match.Source, ""); e != nil {
+		return fmt.Errorf("bad source pattern %#v", self.Match.Source)
+	}
+	if c := self.Match.Calendar; len(c) > 0 && c != CalendarFixed && c != CalendarMovable {
+		return fmt.Errorf("unknown calendar %#v", c)
+	}
+
+	switch self.Action {
+	case ActionAdd:
+		if self.Target == TargetReadings && (self.Reading == nil || len(self.Reading.Book) == 0 || len(self.Reading.Pericope) == 0) {
+			return fmt.Errorf("add needs a reading with a book and pericope")
+		}
+		if self.Target == TargetCommemorations && (self.Commemoration == nil || len(self.Commemoration.Name) == 0) {
+			return fmt.Errorf("add needs a commemoration with a name")
+		}
+		if len(self.Position) > 0 && self.Position != "first" && self.Position != "last" {
+			return fmt.Errorf("unknown position %#v", self.Position)
+		}
+	case ActionRemove:
+	case ActionMove:
+		if self.Offset == 0 {
+			return fmt.Errorf("move needs an offset")
+		}
+	case ActionReorder:
+		if self.Position != "first" && self.Position != "last" {
+			return fmt.Errorf("unknown position %#v", self.Position)
+		}
+	default:
+		return fmt.Errorf("unknown action %#v", self.Action)
+	}
+
+	return nil
+}
+
+// Whether the day satisfies the conditions of the exception
+func (self *Exception) matches(day *Day) bool {
+	if !matchesValue(self.PDists, self.PDistRanges, day.PDist) {
+		return false
+	}
+
+	if len(self.Dates) > 0 {
+		found := false
+		for _, date := range self.Dates {
+			if date.Month == day.Month && date.Day == day.Day {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(self.Weekdays) > 0 && !containsInt(self.Weekdays, day.Weekday) {
+		return false
+	}
+
+	if !matchesValue(self.FeastLevels, self.FeastLevelRanges, day.FeastLevel) {
+		return false
+	}
+
+	if self.MovedParemias && (day.pyear == nil || !day.pyear.HasNoParemias(day.PDist)) {
+		return false
+	}
+
+	for _, name := range self.Feasts {
+		found := false
+		for _, c := range day.Commemorations {
+			if c.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Apply the exception to days[i]. Moved readings and commemorations are lost
+// if the day they are moved to is not in days.
+func (self *Exception) apply(days []*Day, i int, pericopes map[PericopeKey]PericopeRecord) {
+	day := days[i]
+
+	var target *Day
+	if self.Action == ActionMove && i+self.Offset >= 0 && i+self.Offset < len(days) {
+		target = days[i+self.Offset]
+	}
+
+	switch self.Target {
+	case TargetReadings:
+		var kept, matched []Reading
+		for _, r := range day.Readings {
+			if self.Match.matchesReading(r) {
+				matched = append(matched, r)
+			} else {
+				kept = append(kept, r)
+			}
+		}
+
+		switch self.Action {
+		case ActionAdd:
+			p := pericopes[PericopeKey{self.Reading.Book, self.Reading.Pericope}]
+			r := Reading{
+				Source:              self.Reading.Source,
+				Book:                self.Reading.Book,
+				Description:         self.Reading.Description,
+				Display:             p.Display,
+				ShortDisplay:        p.ShortDisplay,
+				Pericope:            self.Reading.Pericope,
+				Pericopes:           splitPericope(self.Reading.Pericope),
+				PericopeDescription: p.Description,
+				Ordering:            self.Reading.Ordering,
+				pericope:            p,
+				fixed:               len(self.Dates) > 0,
+			}
+			r.evening = readingSelector{day: day}.isAddedEvening(r)
+
+			if r.Ordering == 0 {
+				if len(day.Readings) > 0 {
+					r.Ordering = day.Readings[len(day.Readings)-1].Ordering
+				}
+				day.Readings = append(day.Readings, r)
+			} else {
+				day.insertReading(r)
+			}
+		case ActionRemove:
+			day.Readings = kept
+			if self.Match.Description == "Departed" {
+				day.noMemorial = true
+			}
+		case ActionMove:
+			day.Readings = kept
+			if target != nil {
+				for _, r := range matched {
+					// Paremias moved to an earlier day are read on its
+					// evening, as on the weekdays of Lent.
+					r.evening = r.Source == "Vespers" && self.Offset < 0
+					target.insertReading(r)
+				}
+			}
+		case ActionReorder:
+			if self.Position == "first" {
+				day.Readings = append(matched, kept...)
+			} else {
+				day.Readings = append(kept, matched...)
+			}
+		}
+
+	case TargetCommemorations:
+		var kept, matched []Commemoration
+		for _, c := range day.Commemorations {
+			if len(self.Match.Name) == 0 || c.Name == self.Match.Name {
+				matched = append(matched, c)
+			} else {
+				kept = append(kept, c)
+			}
+		}
+
+		switch self.Action {
+		case ActionAdd:
+			c := *self.Commemoration
+			c.FeastLevelDesc = FeastLevels[c.FeastLevel]
+			if c.Movable {
+				c.PDist = day.PDist
+			} else {
+				c.Month, c.Day = day.Month, day.Day
+			}
+			day.addCommemoration(c)
+			if self.Position == "first" {
+				n := len(day.Commemorations)
+				copy(day.Commemorations[1:], day.Commemorations[:n-1])
+				day.Commemorations[0] = c
+			}
+			day.rankCommemorations()
+		case ActionRemove:
+			day.removeCommemorations(matched)
+			day.rankCommemorations()
+		case ActionMove:
+			day.removeCommemorations(matched)
+			day.rankCommemorations()
+			if target != nil {
+				for _, c := range matched {
+					target.addCommemoration(c)
+				}
+				target.rankCommemorations()
+			}
+		case ActionReorder:
+			if self.Position == "first" {
+				day.Commemorations = append(matched, kept...)
+			} else {
+				day.Commemorations = append(kept, matched...)
+			}
+			for j := range day.Commemorations {
+				day.Commemorations[j].Primary = j == 0
+			}
+		}
+	}
+
+	if len(self.ServiceNote) > 0 {
+		day.ServiceNotes = append(day.ServiceNotes, self.ServiceNote)
+	}
+}
+
+func (self ExceptionMatch) matchesReading(r Reading) bool {
+	if len(self.Source) > 0 {
+		if ok, _ := path.Match(self.Source, r.Source); !ok {
+			return false
+		}
+	}
+
+	return (len(self.Description) == 0 || self.Description == r.Description) &&
+		(len(self.Book) == 0 || self.Book == r.Book) &&
+		(len(self.ShortDisplay) == 0 || self.ShortDisplay == r.ShortDisplay) &&
+		(len(self.Calendar) == 0 || (self.Calendar == CalendarFixed) == r.fixed)
+}
+
+// Insert a reading among the readings of the day by its ordering. It goes
+// after the last reading whose ordering is not greater, since a reorder may
+// have left the readings out of order.
+func (self *Day) insertReading(r Reading) {
+	i := len(self.Readings)
+	for i > 0 && self.Readings[i-1].Ordering > r.Ordering {
+		i--
+	}
+
+	self.Readings = append(self.Readings, Reading{})
+	copy(self.Readings[i+1:], self.Readings[i:])
+	self.Readings[i] = r
+}
+
+// Add a commemoration to the day. Feasts, saints and titles are kept in step
+// with the commemorations, and the feast level of the day is raised if
+// necessary. It is not lowered when commemorations are removed.
+func (self *Day) addCommemoration(c Commemoration) {
+	c.Primary = false
+	self.Commemorations = append(self.Commemorations, c)
+
+	if c.FeastLevel > 0 {
+		self.Feasts = append(self.Feasts, c.Name)
+	} else {
+		self.Saints = append(self.Saints, c.Name)
+	}
+
+	if len(c.title) > 0 && !containsString(self.Titles, c.title) {
+		self.Titles = append(self.Titles, c.title)
+	}
+
+	if c.FeastLevel > self.FeastLevel {
+		self.FeastLevel = c.FeastLevel
+		self.FeastLevelDesc = FeastLevels[c.FeastLevel]
+	}
+}
+
+func (self *Day) removeCommemorations(removed []Commemoration) {
+	for _, c := range removed {
+		self.Commemorations = removeCommemoration(self.Commemorations, c.Name)
+		self.Feasts = removeString(self.Feasts, c.Name)
+		self.Saints = removeString(self.Saints, c.Name)
+	}
+
+	// A title goes with the last of the commemorations that have it
+	for _, c := range removed {
+		kept := false
+		for _, k := range self.Commemorations {
+			kept = kept || k.title == c.title
+		}
+		if len(c.title) > 0 && !kept {
+			self.Titles = removeString(self.Titles, c.title)
+		}
+	}
+}
+
+// Order the commemorations by feast level and mark the first one primary.
+func (self *Day) rankCommemorations() {
+	sort.SliceStable(self.Commemorations, func(i, j int) bool {
+		return self.Commemorations[i].FeastLevel > self.Commemorations[j].FeastLevel
+	})
+
+	for i := range self.Commemorations {
+		self.Commemorations[i].Primary = i == 0
+	}
+}
+
+// The largest number of days that an exception moves anything
+func (self *DayFactory) exceptionMargin() int {
+	margin := 0
+	for _, x := range self.exceptions {
+		if x.Action == ActionMove {
+			if x.Offset > margin {
+				margin = x.Offset
+			} else if -x.Offset > margin {
+				margin = -x.Offset
+			}
+		}
+	}
+
+	return margin
+}
+
+// Apply the factory's exceptions to the days in order.
+func (self *DayFactory) applyExceptions(ctx context.Context, days []*Day) error {
+	// Fetch the pericopes of the readings that might be added
+	var keys []PericopeKey
+	for _, x := range self.exceptions {
+		if x.Action == ActionAdd && x.Target == TargetReadings {
+			keys = append(keys, PericopeKey{x.Reading.Book, x.Reading.Pericope})
+		}
+	}
+
+	pericopes := make(map[PericopeKey]PericopeRecord)
+	if len(keys) > 0 {
+		records, e := self.store.Pericopes(ctx, keys)
+		if e != nil {
+			return e
+		}
+		for _, p := range records {
+			pericopes[p.PericopeKey] = p
+		}
+	}
+
+	for i := range self.exceptions {
+		x := &self.exceptions[i]
+
+		// Find the matching days before changing any of them so that a move
+		// cannot make another day match.
+		var matched []int
+		for j, day := range days {
+			if x.matches(day) {
+				matched = append(matched, j)
+			}
+		}
+
+		for _, j := range matched {
+			x.apply(days, j, pericopes)
+		}
+	}
+
+	return nil
+}
+
+// AddExceptions adds exceptions to the ones the factory applies. They are
+// applied after the built-in exceptions in the order given. AddExceptions
+// must not be called while the factory is building days.
+func (self *DayFactory) AddExceptions(exceptions ...Exception) {
+	all := make([]Exception, 0, len(self.exceptions)+len(exceptions))
+	all = append(all, self.exceptions...)
+	self.exceptions = append(all, exceptions...)
+}
+
+// Whether the value is one of the values or within one of the ranges. No
+// values or ranges matches every value.
+func matchesValue(values []int, ranges [][2]int, value int) bool {
+	if len(values) == 0 && len(ranges) == 0 {
+		return true
+	}
+
+	for _, r := range ranges {
+		if r[0] <= value && value <= r[1] {
+			return true
+		}
+	}
+
+	return containsInt(values, value)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeString(values []string, value string) []string {
+	var result []string
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func removeCommemoration(values []Commemoration, name string) []Commemoration {
+	var result []Commemoration
+	for _, c := range values {
+		if c.Name != name {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
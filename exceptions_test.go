@@ -0,0 +1,353 @@
+package orthocal_test
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"strings"
+	"testing"
+)
+
+func TestLoadExceptions(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		exceptions, e := orthocal.LoadExceptions(strings.NewReader(`[
+			{
+				"name": "Parish patron",
+				"dates": [{"month": 7, "day": 10}],
+				"action": "add",
+				"target": "commemorations",
+				"commemoration": {"name": "St Anthony", "feast_level": 4}
+			}
+		]`))
+		if e != nil {
+			t.Fatalf("Got error loading exceptions: %#v.", e)
+		}
+
+		if len(exceptions) != 1 || exceptions[0].Dates[0] != (orthocal.MonthDay{Month: 7, Day: 10}) || exceptions[0].Commemoration.FeastLevel != 4 {
+			t.Errorf("Got incorrect exceptions: %#v.", exceptions)
+		}
+	})
+
+	invalid := map[string]string{
+		"Syntax":         `[{"action": "add"`,
+		"Unknown Action": `[{"action": "swap", "target": "readings"}]`,
+		"Unknown Target": `[{"action": "remove", "target": "fasts"}]`,
+		"Add Reading":    `[{"action": "add", "target": "readings", "reading": {"book": "John"}}]`,
+		"Move":           `[{"action": "move", "target": "readings"}]`,
+		"Reorder":        `[{"action": "reorder", "target": "readings", "position": "middle"}]`,
+		"Add Position":   `[{"action": "add", "target": "commemorations", "commemoration": {"name": "St Anthony"}, "position": "middle"}]`,
+		"Range":          `[{"pdist_ranges": [[-8, -41]], "action": "remove", "target": "readings"}]`,
+		"Source":         `[{"action": "remove", "target": "readings", "match": {"source": "[Matins"}}]`,
+		"Calendar":       `[{"action": "remove", "target": "readings", "match": {"calendar": "julian"}}]`,
+	}
+
+	for name, data := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, e := orthocal.LoadExceptions(strings.NewReader(data))
+			if !errors.Is(e, orthocal.ErrInvalidException) {
+				t.Errorf("Loading %s should fail with ErrInvalidException but got %#v.", data, e)
+			}
+		})
+	}
+}
+
+func TestExceptions(t *testing.T) {
+	db, e := sql.Open("sqlite3", "oca_calendar.db")
+	if e != nil {
+		t.Errorf("Got error opening database: %#v.", e)
+	}
+
+	newFactory := func(data string) *orthocal.DayFactory {
+		exceptions, e := orthocal.LoadExceptions(strings.NewReader(data))
+		if e != nil {
+			t.Fatalf("Got error loading exceptions: %#v.", e)
+		}
+
+		factory := orthocal.NewDayFactory(false, true, db)
+		factory.AddExceptions(exceptions...)
+		return factory
+	}
+
+	plain := orthocal.NewDayFactory(false, true, db)
+
+	t.Run("Remove", func(t *testing.T) {
+		factory := newFactory(`[{"dates": [{"month": 7, "day": 10}], "weekdays": [2], "action": "remove", "target": "readings", "match": {"source": "Gospel"}}]`)
+
+		// 7/10/2018 is a Tuesday and 7/10/2019 is a Wednesday
+		for _, r := range factory.NewDay(2018, 7, 10, nil).Readings {
+			if r.Source == "Gospel" {
+				t.Errorf("7/10/2018 should not have a Gospel but has %s.", r.ShortDisplay)
+			}
+		}
+
+		if len(factory.NewDay(2019, 7, 10, nil).Readings) != len(plain.NewDay(2019, 7, 10, nil).Readings) {
+			t.Errorf("7/10/2019 should not be changed since it is not a Tuesday.")
+		}
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		factory := newFactory(`[
+			{"dates": [{"month": 7, "day": 10}], "action": "add", "target": "readings", "reading": {"source": "Epistle", "description": "Patron", "book": "Apostol", "pericope": "284"}},
+			{"dates": [{"month": 7, "day": 10}], "action": "add", "target": "commemorations", "commemoration": {"name": "Parish Patron", "feast_level": 5}, "service_note": "Patronal feast"}
+		]`)
+		day := factory.NewDay(2018, 7, 10, nil)
+
+		last := day.Readings[len(day.Readings)-1]
+		if last.Description != "Patron" || last.ShortDisplay != "1 Tim 3.14-4.5" {
+			t.Errorf("7/10/2018 should end with the patron's Epistle but got %#v.", last)
+		}
+
+		primary := day.Commemorations[0]
+		if primary.Name != "Parish Patron" || !primary.Primary || primary.FeastLevelDesc != orthocal.FeastLevels[5] {
+			t.Errorf("The parish patron should be the primary commemoration but got %#v.", day.Commemorations)
+		}
+		if day.FeastLevel != 5 || day.Feasts[len(day.Feasts)-1] != "Parish Patron" {
+			t.Errorf("7/10/2018 should have the parish patron's feast level but has %d.", day.FeastLevel)
+		}
+		if day.ServiceNotes[len(day.ServiceNotes)-1] != "Patronal feast" {
+			t.Errorf("7/10/2018 should have a note for the patronal feast but has %#v.", day.ServiceNotes)
+		}
+	})
+
+	t.Run("Add Ordering", func(t *testing.T) {
+		factory := newFactory(`[{"dates": [{"month": 7, "day": 10}], "action": "add", "target": "readings", "reading": {"source": "Epistle", "description": "Patron", "book": "Apostol", "pericope": "284", "ordering": 850}}]`)
+		day := factory.NewDay(2018, 7, 10, nil)
+
+		// The patron's Epistle goes after St Anthony's and before the Gospels
+		var descriptions []string
+		for _, r := range day.Readings {
+			descriptions = append(descriptions, r.Source+" "+r.Description)
+		}
+		for i, r := range day.Readings {
+			if r.Description != "Patron" {
+				continue
+			}
+			if i == 0 || i == len(day.Readings)-1 || day.Readings[i-1].Description != "St Anthony" || day.Readings[i+1].Source != "Gospel" {
+				t.Errorf("The patron's Epistle should follow St Anthony's but got %#v.", descriptions)
+			}
+		}
+	})
+
+	t.Run("Add Evening", func(t *testing.T) {
+		factory := newFactory(`[{"dates": [{"month": 12, "day": 24}], "action": "add", "target": "readings", "reading": {"source": "Vespers", "description": "Added", "book": "OT", "pericope": "100", "ordering": 650}}]`)
+		factory.SetEveServices(true)
+
+		// Vespers is joined to the Liturgy on the eve of Nativity, so the
+		// added reading is read on the evening of the day itself.
+		found := func(eve *orthocal.Eve) bool {
+			for _, r := range eve.Readings {
+				if r.Description == "Added" {
+					return true
+				}
+			}
+			return false
+		}
+		if !found(factory.NewDay(2018, 12, 24, nil).Eve) {
+			t.Errorf("The added reading should be read on the evening of 12/24/2018.")
+		}
+		if found(factory.NewDay(2018, 12, 23, nil).Eve) {
+			t.Errorf("The added reading should not be read on the evening of 12/23/2018.")
+		}
+	})
+
+	t.Run("Add First", func(t *testing.T) {
+		factory := newFactory(`[{"pdists": [39], "action": "add", "target": "commemorations", "commemoration": {"name": "Parish Patron", "feast_level": 8, "movable": true}, "position": "first"}]`)
+		day := factory.NewDay(2018, 5, 17, nil)
+
+		// The patron goes before Ascension, which has the same feast level
+		if c := day.Commemorations[0]; c.Name != "Parish Patron" || !c.Primary || !c.Movable || c.PDist != 39 || c.Month != 0 {
+			t.Errorf("The parish patron should be the primary commemoration of Ascension but got %#v.", day.Commemorations)
+		}
+		if c := day.Commemorations[1]; c.Name != "Ascension of the Lord" || c.Primary {
+			t.Errorf("Ascension should follow the parish patron but got %#v.", day.Commemorations)
+		}
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		factory := newFactory(`[{"dates": [{"month": 7, "day": 10}], "action": "move", "target": "readings", "offset": 1, "match": {"source": "Gospel"}}]`)
+
+		var moved string
+		for _, r := range plain.NewDay(2018, 7, 10, nil).Readings {
+			if r.Source == "Gospel" {
+				moved = r.ShortDisplay
+			}
+		}
+
+		// The day the readings come from is not requested
+		day := factory.NewDay(2018, 7, 11, nil)
+
+		found := false
+		for _, r := range day.Readings {
+			if r.ShortDisplay == moved {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("7/11/2018 should have the Gospel of 7/10/2018, %s, but has %#v.", moved, day.Readings)
+		}
+	})
+
+	t.Run("Ranges", func(t *testing.T) {
+		factory := newFactory(`[{"pdist_ranges": [[-48, -1]], "feast_level_ranges": [[-2, 6]], "action": "remove", "target": "readings", "match": {"source": "Epistle"}}]`)
+
+		// 3/13/2018 is in Lent and 3/25/2018 is the Annunciation
+		for _, test := range []struct {
+			month, day int
+			epistle    bool
+		}{{3, 13, false}, {3, 25, true}, {4, 10, true}} {
+			found := false
+			for _, r := range factory.NewDay(2018, test.month, test.day, nil).Readings {
+				found = found || r.Source == "Epistle"
+			}
+			if found != test.epistle {
+				t.Errorf("%d/%d/2018 should have an Epistle %v but has %v.", test.month, test.day, test.epistle, found)
+			}
+		}
+	})
+
+	t.Run("Calendar", func(t *testing.T) {
+		factory := newFactory(`[{"dates": [{"month": 9, "day": 14}], "action": "remove", "target": "readings", "match": {"calendar": "fixed"}}]`)
+
+		// Only the daily readings are left on the Exaltation
+		day := factory.NewDay(2018, 9, 14, nil)
+		if len(day.Readings) != 2 {
+			t.Errorf("9/14/2018 should have only the daily readings but has %#v.", day.Readings)
+		}
+		for _, r := range day.Readings {
+			if r.Source != "Epistle" && r.Source != "Gospel" {
+				t.Errorf("9/14/2018 should have only the daily readings but has %s %s.", r.Source, r.ShortDisplay)
+			}
+		}
+	})
+
+	t.Run("Titles", func(t *testing.T) {
+		// 1/28/2018 is the Sunday of the Publican and the Pharisee
+		title := "Sunday of the Publican and the Pharisee"
+		hasTitle := func(day *orthocal.Day) bool {
+			for _, t := range day.Titles {
+				if t == title {
+					return true
+				}
+			}
+			return false
+		}
+
+		factory := newFactory(`[{"pdists": [-70], "action": "remove", "target": "commemorations", "match": {"name": "Beginning of the Lenten Triodion"}}]`)
+		if !hasTitle(plain.NewDay(2018, 1, 28, nil)) {
+			t.Fatalf("1/28/2018 should have the title %#v.", title)
+		}
+		if day := factory.NewDay(2018, 1, 28, nil); hasTitle(day) {
+			t.Errorf("1/28/2018 should lose its title with its commemoration but has %#v.", day.Titles)
+		}
+
+		factory = newFactory(`[{"pdists": [-70], "action": "move", "target": "commemorations", "offset": 1, "match": {"name": "Beginning of the Lenten Triodion"}}]`)
+		if day := factory.NewDay(2018, 1, 28, nil); hasTitle(day) {
+			t.Errorf("1/28/2018 should lose its title with its commemoration but has %#v.", day.Titles)
+		}
+		if day := factory.NewDay(2018, 1, 29, nil); !hasTitle(day) {
+			t.Errorf("1/29/2018 should have the title of the moved commemoration but has %#v.", day.Titles)
+		}
+	})
+
+	t.Run("Reorder", func(t *testing.T) {
+		factory := newFactory(`[{"feasts": ["Hieromartyr Euthymius of Sardis"], "action": "reorder", "target": "commemorations", "position": "first", "match": {"name": "Hieromartyr Euthymius of Sardis"}}]`)
+		day := factory.NewDay(2018, 12, 26, nil)
+
+		if c := day.Commemorations[0]; c.Name != "Hieromartyr Euthymius of Sardis" || !c.Primary || day.Commemorations[1].Primary {
+			t.Errorf("St Euthymius should be the primary commemoration but got %#v.", day.Commemorations)
+		}
+	})
+
+	t.Run("No Memorial", func(t *testing.T) {
+		// 3/26/2022 is the third Saturday of Lent and 3/19/2022 the second
+		if !plain.NewDay(2022, 3, 26, nil).HasNoMemorial() || plain.NewDay(2022, 3, 19, nil).HasNoMemorial() {
+			t.Errorf("Only 3/26/2022 should have no memorial for the departed.")
+		}
+
+		factory := newFactory(`[{"pdists": [-36], "action": "remove", "target": "readings", "match": {"description": "Departed"}}]`)
+		if !factory.NewDay(2022, 3, 19, nil).HasNoMemorial() {
+			t.Errorf("3/19/2022 should have no memorial for the departed with the factory's exception.")
+		}
+	})
+
+	t.Run("Moved Paremias", func(t *testing.T) {
+		// 3/9/2018 is a Friday of Lent whose paremias the year moves
+		factory := newFactory(`[{"moved_paremias": true, "action": "add", "target": "commemorations", "commemoration": {"name": "Moved"}}]`)
+		for _, test := range []struct {
+			day   int
+			moved bool
+		}{{9, true}, {8, false}, {10, false}} {
+			moved := false
+			for _, c := range factory.NewDay(2018, 3, test.day, nil).Commemorations {
+				moved = moved || c.Name == "Moved"
+			}
+			if moved != test.moved {
+				t.Errorf("3/%d/2018 should have its paremias moved %v but has %v.", test.day, test.moved, moved)
+			}
+		}
+	})
+
+	t.Run("Builtin", func(t *testing.T) {
+		// The Annunciation leavetaking rule does not apply on a Wednesday
+		day := plain.NewDay(2025, 3, 26, nil)
+
+		found := false
+		for _, r := range day.Readings {
+			if r.Description == "Theotokos" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("3/26/2025 should have readings for the Theotokos but doesn't.")
+		}
+
+		// 3/26/2022 is the third Saturday of Lent and 3/19/2022 the second
+		for _, test := range []struct {
+			day      int
+			departed bool
+		}{{26, false}, {19, true}} {
+			departed := false
+			for _, r := range plain.NewDay(2022, 3, test.day, nil).Readings {
+				departed = departed || r.Description == "Departed"
+			}
+			if departed != test.departed {
+				t.Errorf("3/%d/2022 should have readings for the departed %v but has %v.", test.day, test.departed, departed)
+			}
+		}
+
+		// The Transfiguration fell on a Sunday in 2023
+		for _, test := range []struct {
+			day          int
+			matinsGospel string
+		}{{6, "Matins Gospel"}, {13, "10th Matins Gospel"}} {
+			var sources []string
+			for _, r := range plain.NewDay(2023, 8, test.day, nil).Readings {
+				if strings.HasSuffix(r.Source, "Matins Gospel") {
+					sources = append(sources, r.Source)
+				}
+			}
+			if len(sources) != 1 || sources[0] != test.matinsGospel {
+				t.Errorf("8/%d/2023 should have the %s but has %#v.", test.day, test.matinsGospel, sources)
+			}
+		}
+
+		// The Forty Martyrs fell on a Friday of Lent in 2018
+		day = plain.NewDay(2018, 3, 9, nil)
+		if len(day.Readings) == 0 || day.Readings[0].Source != "Matins Gospel" {
+			t.Errorf("3/9/2018 should begin with the Matins Gospel but has %#v.", day.Readings)
+		}
+		for _, r := range day.Readings {
+			if r.Source == "Vespers" && r.Description == "Martyrs" {
+				t.Errorf("The paremias of 3/9/2018 should be moved to 3/8/2018 but %s isn't.", r.ShortDisplay)
+			}
+		}
+		found = false
+		for _, r := range plain.NewDay(2018, 3, 8, nil).Readings {
+			found = found || (r.Source == "Vespers" && r.Description == "Martyrs")
+		}
+		if !found {
+			t.Errorf("3/8/2018 should have the paremias of the Forty Martyrs but doesn't.")
+		}
+	})
+}
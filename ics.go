@@ -0,0 +1,212 @@
+package orthocal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// iCalendar
+//
+// An ICSWriter writes Days as an iCalendar (RFC 5545) feed of all-day events
+// so that the calendar can be subscribed to in calendar apps. The UID of each
+// event is derived from its date so that a refreshed feed replaces the events
+// rather than duplicating them.
+
+// An ICSFilter selects the days that are written.
+type ICSFilter int
+
+const (
+	ICSAllDays ICSFilter = iota // every day
+	ICSFeasts                   // days with feasts
+	ICSFasts                    // days with a fast
+)
+
+// The longest line allowed by RFC 5545 in octets, not counting the line break
+const icsLineLength = 75
+
+type ICSWriter struct {
+	w         io.Writer
+	name      string
+	domain    string
+	filter    ICSFilter
+	timestamp time.Time
+}
+
+// NewICSWriter returns an ICSWriter that writes all days to w.
+func NewICSWriter(w io.Writer) *ICSWriter {
+	var self ICSWriter
+	self.w = w
+	self.domain = "orthocal"
+	return &self
+}
+
+// SetName sets the name of the calendar that is shown by calendar apps.
+func (self *ICSWriter) SetName(name string) {
+	self.name = name
+}
+
+// SetDomain sets the domain of the UIDs of the events, e.g. example.org. Feeds
+// with different calendars, such as Julian and Gregorian, should have
+// different domains.
+func (self *ICSWriter) SetDomain(domain string) {
+	self.domain = domain
+}
+
+// SetFilter sets the days that are written.
+func (self *ICSWriter) SetFilter(filter ICSFilter) {
+	self.filter = filter
+}
+
+// SetTimestamp sets the time the events are stamped with. The current time is
+// used if the timestamp is zero.
+func (self *ICSWriter) SetTimestamp(timestamp time.Time) {
+	self.timestamp = timestamp
+}
+
+// Write writes a calendar with an event for each of the days that passes the
+// filter.
+func (self *ICSWriter) Write(days []*Day) error {
+	w := bufio.NewWriter(self.w)
+
+	timestamp := self.timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	stamp := timestamp.UTC().Format("20060102T150405Z")
+
+	writeICSLine(w, "BEGIN:VCALENDAR")
+	writeICSLine(w, "VERSION:2.0")
+	writeICSLine(w, "PRODID:-//orthocal//orthocal//EN")
+	writeICSLine(w, "CALSCALE:GREGORIAN")
+	writeICSLine(w, "METHOD:PUBLISH")
+	if len(self.name) > 0 {
+		writeICSLine(w, "X-WR-CALNAME:"+escapeICSText(self.name))
+	}
+
+	for _, day := range days {
+		if !self.includes(day) {
+			continue
+		}
+
+		// Julian days are still given by their civil date
+		start := icsDate(day.JDN)
+
+		writeICSLine(w, "BEGIN:VEVENT")
+		writeICSLine(w, fmt.Sprintf("UID:%s@%s", start, self.domain))
+		writeICSLine(w, "DTSTAMP:"+stamp)
+		writeICSLine(w, "DTSTART;VALUE=DATE:"+start)
+		writeICSLine(w, "DTEND;VALUE=DATE:"+icsDate(day.JDN+1))
+		writeICSLine(w, "SUMMARY:"+escapeICSText(self.summary(day)))
+		writeICSLine(w, "DESCRIPTION:"+escapeICSText(icsDescription(day)))
+		if categories := icsCategories(day); len(categories) > 0 {
+			writeICSLine(w, "CATEGORIES:"+categories)
+		}
+		writeICSLine(w, "TRANSP:TRANSPARENT")
+		writeICSLine(w, "END:VEVENT")
+	}
+
+	writeICSLine(w, "END:VCALENDAR")
+	return w.Flush()
+}
+
+func (self *ICSWriter) includes(day *Day) bool {
+	switch self.filter {
+	case ICSFeasts:
+		return len(day.Feasts) > 0
+	case ICSFasts:
+		return day.FastLevel != NoFast
+	}
+
+	return true
+}
+
+// The summary is the most important thing about the day for the filter.
+func (self *ICSWriter) summary(day *Day) string {
+	if self.filter == ICSFasts {
+		return day.FastSummary()
+	}
+
+	switch {
+	case len(day.Titles) > 0:
+		return day.Titles[0]
+	case len(day.Feasts) > 0:
+		return day.Feasts[0]
+	case len(day.Saints) > 0:
+		return day.Saints[0]
+	}
+
+	return day.FastSummary()
+}
+
+func icsDescription(day *Day) string {
+	var lines []string
+
+	lines = append(lines, day.Titles...)
+	lines = append(lines, day.Feasts...)
+	lines = append(lines, day.Saints...)
+
+	if len(lines) > 0 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, day.FastSummary())
+
+	if len(day.Readings) > 0 {
+		lines = append(lines, "", "Readings:")
+		for _, reading := range day.Readings {
+			lines = append(lines, fmt.Sprintf("%s: %s", reading.Source, reading.Display))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// The categories are the feast level and the fast of the day.
+func icsCategories(day *Day) string {
+	var categories []string
+	for _, category := range []string{day.FeastLevelDesc, day.FastLevelDesc} {
+		if len(category) > 0 {
+			categories = append(categories, escapeICSText(category))
+		}
+	}
+
+	return strings.Join(categories, ",")
+}
+
+// Returns the civil date of a Julian day number in the iCalendar format.
+func icsDate(jdn int) string {
+	year, month, day := JDNToGregorianDate(jdn)
+	return fmt.Sprintf("%04d%02d%02d", year, month, day)
+}
+
+var icsTextReplacer = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+func escapeICSText(text string) string {
+	return icsTextReplacer.Replace(text)
+}
+
+// Write a content line, folded so that no line is longer than icsLineLength
+// octets. Lines are never folded within a UTF-8 character. Errors are
+// returned by Flush.
+func writeICSLine(w *bufio.Writer, line string) {
+	limit := icsLineLength
+	for len(line) > limit {
+		n := limit
+		for n > 0 && !utf8.RuneStart(line[n]) {
+			n--
+		}
+
+		w.WriteString(line[:n])
+		w.WriteString("\r\n ")
+		line = line[n:]
+
+		// The leading space of a continuation line counts toward its length
+		limit = icsLineLength - 1
+	}
+
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
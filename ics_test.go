@@ -0,0 +1,174 @@
+package orthocal_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Unfold the lines of an iCalendar feed.
+func unfoldICS(feed string) []string {
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(feed, "\r\n ", ""), "\r\n"), "\r\n")
+}
+
+func writeICS(t *testing.T, days []*orthocal.Day, filter orthocal.ICSFilter) string {
+	var buffer bytes.Buffer
+
+	w := orthocal.NewICSWriter(&buffer)
+	w.SetName("Orthodox Calendar")
+	w.SetFilter(filter)
+	w.SetTimestamp(time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC))
+	if e := w.Write(days); e != nil {
+		t.Fatalf("Got error writing iCalendar: %#v.", e)
+	}
+
+	return buffer.String()
+}
+
+func TestICSWriter(t *testing.T) {
+	db, e := sql.Open("sqlite3", "oca_calendar.db")
+	if e != nil {
+		t.Fatalf("Got error opening database: %#v.", e)
+	}
+
+	factory := orthocal.NewDayFactory(false, true, db)
+
+	// Sunday of the Prodigal Son through Forgiveness Sunday
+	start := time.Date(2018, 2, 4, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2018, 2, 18, 0, 0, 0, 0, time.UTC)
+	days, e := factory.NewDaysInRange(context.Background(), start, end, nil)
+	if e != nil {
+		t.Fatalf("Got error building days: %#v.", e)
+	}
+
+	t.Run("Format", func(t *testing.T) {
+		feed := writeICS(t, days, orthocal.ICSAllDays)
+
+		if !strings.HasPrefix(feed, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(feed, "END:VCALENDAR\r\n") {
+			t.Errorf("The feed should be a calendar but is %#v.", feed)
+		}
+
+		for _, line := range strings.Split(strings.TrimSuffix(feed, "\r\n"), "\r\n") {
+			if len(line) > 75 {
+				t.Errorf("Line %#v is longer than 75 octets.", line)
+			}
+			if strings.Contains(line, "\n") {
+				t.Errorf("Line %#v should end in CRLF.", line)
+			}
+		}
+
+		lines := unfoldICS(feed)
+		if count := strings.Count(feed, "BEGIN:VEVENT"); count != 15 {
+			t.Errorf("The feed should have 15 events but has %d.", count)
+		}
+
+		for _, expected := range []string{
+			"X-WR-CALNAME:Orthodox Calendar",
+			"UID:20180204@orthocal",
+			"DTSTAMP:20180101T120000Z",
+			"DTSTART;VALUE=DATE:20180204",
+			"DTEND;VALUE=DATE:20180205",
+			"SUMMARY:Sunday of the Prodigal Son",
+			"CATEGORIES:Liturgy,No Fast",
+		} {
+			found := false
+			for _, line := range lines {
+				if line == expected {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("The feed should have the line %#v but doesn't.", expected)
+			}
+		}
+	})
+
+	t.Run("Description", func(t *testing.T) {
+		feed := writeICS(t, days[:1], orthocal.ICSAllDays)
+
+		for _, line := range unfoldICS(feed) {
+			if strings.HasPrefix(line, "DESCRIPTION:") {
+				for _, r := range days[0].Readings {
+					display := strings.ReplaceAll(r.Display, ",", `\,`)
+					display = strings.ReplaceAll(display, ";", `\;`)
+					if !strings.Contains(line, display) {
+						t.Errorf("The description should have the reading %#v but is %#v.", r.Display, line)
+					}
+				}
+			}
+			if strings.HasPrefix(line, "CATEGORIES:") && !strings.Contains(line, days[0].FeastLevelDesc) {
+				t.Errorf("The categories should have the feast level %#v but are %#v.", days[0].FeastLevelDesc, line)
+			}
+		}
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		feasts, fasts := 0, 0
+		for _, day := range days {
+			if len(day.Feasts) > 0 {
+				feasts++
+			}
+			if day.FastLevel != orthocal.NoFast {
+				fasts++
+			}
+		}
+		if fasts == 0 || fasts == len(days) {
+			t.Fatalf("Some but not all of the days should be fasts but %d are.", fasts)
+		}
+
+		if count := strings.Count(writeICS(t, days, orthocal.ICSFeasts), "BEGIN:VEVENT"); count != feasts {
+			t.Errorf("The feast feed should have %d events but has %d.", feasts, count)
+		}
+
+		feed := writeICS(t, days, orthocal.ICSFasts)
+		if count := strings.Count(feed, "BEGIN:VEVENT"); count != fasts {
+			t.Errorf("The fast feed should have %d events but has %d.", fasts, count)
+		}
+		if !strings.Contains(feed, "SUMMARY:Fast (Meat Fast)") {
+			t.Errorf("The fast feed should be summarized by the fast.")
+		}
+	})
+
+	t.Run("Julian", func(t *testing.T) {
+		factory := orthocal.NewDayFactory(true, true, db)
+
+		// Nativity is on January 7 of the civil calendar
+		day := factory.NewDay(2019, 1, 7, nil)
+		if day.Month != 12 || day.Day != 25 {
+			t.Fatalf("1/7/2019 should be 12/25 of the Julian calendar but is %d/%d.", day.Month, day.Day)
+		}
+
+		feed := writeICS(t, []*orthocal.Day{day}, orthocal.ICSAllDays)
+		if !strings.Contains(feed, "DTSTART;VALUE=DATE:20190107\r\n") || !strings.Contains(feed, "UID:20190107@orthocal\r\n") {
+			t.Errorf("12/25/2018 of the Julian calendar should be on 20190107 but the feed is %#v.", feed)
+		}
+	})
+
+	t.Run("Escaping", func(t *testing.T) {
+		title := "Saints Cyril, Methodius; and " + strings.Repeat("Παναγία ", 20)
+		day := &orthocal.Day{JDN: days[0].JDN, Titles: []string{title}}
+
+		feed := writeICS(t, []*orthocal.Day{day}, orthocal.ICSAllDays)
+		for _, line := range strings.Split(feed, "\r\n") {
+			if len(line) > 75 {
+				t.Errorf("Line %#v is longer than 75 octets.", line)
+			}
+		}
+
+		expected := "SUMMARY:Saints Cyril\\, Methodius\\; and " + strings.Repeat("Παναγία ", 20)
+		found := false
+		for _, line := range unfoldICS(feed) {
+			if line == expected {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("The feed should have the line %#v but is %#v.", expected, feed)
+		}
+	})
+}
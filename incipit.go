@@ -0,0 +1,168 @@
+package orthocal
+
+import (
+	"html"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Incipits
+//
+// Pericopes are proclaimed in church with an incipit such as "At that time,"
+// that takes the place of the opening words of the first verse. Some also
+// have a second incipit for a later part of the pericope or a suffix that
+// closes the reading.
+
+// Connecting words at the start of a verse that the incipit replaces
+var incipitConnectives = map[string]bool{
+	"and": true, "but": true, "for": true, "now": true, "so": true, "then": true,
+}
+
+// Words that are no longer capitalized once an incipit comes before them
+var incipitLowercase = map[string]bool{
+	"a": true, "after": true, "all": true, "an": true, "as": true, "at": true,
+	"behold": true, "in": true, "it": true, "many": true, "on": true,
+	"one": true, "some": true, "the": true, "there": true, "they": true,
+	"when": true, "while": true,
+}
+
+// The number of words at the start of a verse in which the last word of an
+// incipit is looked for
+const incipitWords = 10
+
+// Apply the incipits and suffix of the pericope to the passage. The passage
+// is copied rather than changed.
+func applyIncipit(passage Passage, p PericopeRecord) Passage {
+	if len(passage) == 0 {
+		return passage
+	}
+
+	passage = append(Passage(nil), passage...)
+
+	prefix := html.UnescapeString(p.Prefix)
+	if len(strings.TrimSpace(prefix)) > 0 {
+		first := &passage[0]
+		if chapter, verse, ok := parseVerseNumber(p.Preverse); ok && first.Chapter == chapter && first.Verse == verse {
+			// The incipit replaces the whole verse
+			first.Content = strings.TrimSpace(prefix)
+		} else {
+			first.Content = prependIncipit(prefix, first.Content)
+		}
+	}
+
+	// The second incipit belongs to the first verse of the second part of
+	// the pericope.
+	prefixB := html.UnescapeString(p.PrefixB)
+	if len(strings.TrimSpace(prefixB)) > 0 {
+		if chapter, verse, ok := secondPartStart(p.Verses); ok {
+			for i := range passage {
+				if passage[i].Chapter == chapter && passage[i].Verse == verse {
+					passage[i].Content = prependIncipit(prefixB, passage[i].Content)
+					break
+				}
+			}
+		}
+	}
+
+	suffix := html.UnescapeString(p.Suffix)
+	if len(strings.TrimSpace(suffix)) > 0 {
+		last := &passage[len(passage)-1]
+		last.Content = appendSuffix(last.Content, suffix)
+	}
+
+	return passage
+}
+
+// Put the incipit in place of the opening words of the text. If the last
+// word of the incipit is among the opening words, everything up to and
+// including it is replaced. Otherwise a leading connective is dropped.
+func prependIncipit(incipit, text string) string {
+	incipit = strings.TrimSpace(incipit)
+	text = strings.TrimSpace(text)
+
+	incipitFields := strings.Fields(incipit)
+	lastWord := normalizeWord(incipitFields[len(incipitFields)-1])
+
+	fields := strings.Fields(text)
+	for i := 0; i < len(fields) && i < incipitWords; i++ {
+		if len(lastWord) > 0 && normalizeWord(fields[i]) == lastWord {
+			rest := strings.Join(fields[i+1:], " ")
+			if len(rest) == 0 {
+				return incipit
+			}
+			return incipit + " " + rest
+		}
+	}
+
+	if len(fields) > 1 && incipitConnectives[normalizeWord(fields[0])] {
+		fields = fields[1:]
+	}
+	if len(fields) > 0 {
+		if strings.HasSuffix(incipit, ".") || strings.HasSuffix(incipit, ":") {
+			// A new sentence or quotation starts with a capital
+			fields[0] = capitalize(fields[0])
+		} else if incipitLowercase[normalizeWord(fields[0])] {
+			fields[0] = strings.ToLower(fields[0])
+		}
+	}
+
+	return incipit + " " + strings.Join(fields, " ")
+}
+
+// Close the text with the suffix. A suffix that ends the sentence replaces the
+// punctuation at the end of the text and other punctuation, such as a closing
+// quotation mark, is added directly.
+func appendSuffix(text, suffix string) string {
+	text = strings.TrimRightFunc(text, unicode.IsSpace)
+	suffix = strings.TrimSpace(suffix)
+
+	first := []rune(suffix)[0]
+	switch {
+	case first == '.':
+		return strings.TrimRight(text, ",;:.") + suffix
+	case unicode.IsPunct(first):
+		return text + suffix
+	}
+
+	return text + " " + suffix
+}
+
+func capitalize(word string) string {
+	runes := []rune(word)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// Lowercase a word and remove surrounding punctuation for comparison.
+func normalizeWord(word string) string {
+	return strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}))
+}
+
+// Parse a verse number of the form CCCVVV, e.g. 12009 for chapter 12 verse 9.
+func parseVerseNumber(number string) (chapter, verse uint16, ok bool) {
+	n, e := strconv.Atoi(strings.TrimSpace(number))
+	if e != nil || n <= 0 {
+		return 0, 0, false
+	}
+
+	return uint16(n / 1000), uint16(n % 1000), true
+}
+
+// Find the first verse of the second part of a pericope from its verses
+// column, e.g. Matt_6031_6034|Matt_7009_7011.
+func secondPartStart(verses string) (chapter, verse uint16, ok bool) {
+	parts := strings.Split(verses, "|")
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+
+	fields := strings.Split(parts[1], "_")
+	if len(fields) < 2 {
+		return 0, 0, false
+	}
+
+	return parseVerseNumber(fields[1])
+}
@@ -0,0 +1,131 @@
+package orthocal_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"strings"
+	"testing"
+)
+
+// verseBible returns passages from a fixed set of references.
+type verseBible map[string]orthocal.Passage
+
+func (self verseBible) Lookup(reference string) orthocal.Passage {
+	return self.LookupWithContext(context.Background(), reference)
+}
+
+func (self verseBible) LookupWithContext(ctx context.Context, reference string) orthocal.Passage {
+	return self[reference]
+}
+
+var incipitBible = verseBible{
+	"Matt 12.9-13": {
+		{"Matthew", 12, 9, "Now when He had departed from there, He went into their synagogue."},
+		{"Matthew", 12, 10, "And behold, there was a man who had a withered hand."},
+		{"Matthew", 12, 13, "Then He said to the man, \"Stretch out your hand.\" And he stretched it out, and it was restored as whole as the other."},
+	},
+	"Mark 5.22-24, 35-6.1": {
+		{"Mark", 5, 22, "And behold, one of the rulers of the synagogue came, Jairus by name. And when he saw Him, he fell at His feet"},
+		{"Mark", 5, 24, "So Jesus went with him, and a great multitude followed Him and thronged Him."},
+		{"Mark", 5, 35, "While He was still speaking, some came from the ruler of the synagogue's house who said, \"Your daughter is dead.\""},
+		{"Mark", 6, 1, "Then He went out from there and came to His own country, and His disciples followed Him."},
+	},
+	"Matt 25.14-30": {
+		{"Matthew", 25, 14, "For the kingdom of heaven is like a man traveling to a far country, who called his own servants and delivered his goods to them."},
+		{"Matthew", 25, 30, "And cast the unprofitable servant into the outer darkness. There will be weeping and gnashing of teeth."},
+	},
+	"Matt 5.14-19": {
+		{"Matthew", 5, 14, "You are the light of the world. A city that is set on a hill cannot be hidden."},
+		{"Matthew", 5, 19, "Whoever therefore breaks one of the least of these commandments, and teaches men so, shall be called least in the kingdom of heaven."},
+	},
+}
+
+func TestIncipits(t *testing.T) {
+	db, e := sql.Open("sqlite3", "oca_calendar.db")
+	if e != nil {
+		t.Errorf("Got error opening database: %#v.", e)
+	}
+
+	factory := orthocal.NewDayFactory(false, true, db)
+
+	raw := orthocal.NewDayFactory(false, true, db)
+	raw.SetRawScripture(true)
+
+	// Find the passage of the reading on the given day
+	passage := func(t *testing.T, f *orthocal.DayFactory, year, month, day int, reference string) orthocal.Passage {
+		for _, r := range f.NewDay(year, month, day, incipitBible).Readings {
+			if r.ShortDisplay == reference {
+				return r.Passage
+			}
+		}
+
+		t.Fatalf("%d/%d/%d should have %s but doesn't.", month, day, year, reference)
+		return nil
+	}
+
+	t.Run("Prefix", func(t *testing.T) {
+		// The Three Hierarchs
+		p := passage(t, factory, 2018, 1, 30, "Matt 5.14-19")
+
+		expected := "The Lord said to his disciples: You are the light of the world. A city that is set on a hill cannot be hidden."
+		if p[0].Content != expected {
+			t.Errorf("The first verse should be %#v but is %#v.", expected, p[0].Content)
+		}
+		if p[1].Content != incipitBible["Matt 5.14-19"][1].Content {
+			t.Errorf("The last verse should not be changed but is %#v.", p[1].Content)
+		}
+	})
+
+	t.Run("Preverse", func(t *testing.T) {
+		p := passage(t, factory, 2018, 6, 25, "Matt 12.9-13")
+
+		if expected := "At that time, Jesus went into the Jewish synagogue."; p[0].Content != expected {
+			t.Errorf("The first verse should be replaced by %#v but is %#v.", expected, p[0].Content)
+		}
+		if p[1].Content != incipitBible["Matt 12.9-13"][1].Content {
+			t.Errorf("The second verse should not be changed but is %#v.", p[1].Content)
+		}
+	})
+
+	t.Run("Second Prefix", func(t *testing.T) {
+		p := passage(t, factory, 2018, 8, 31, "Mark 5.22-24, 35-6.1")
+
+		if expected := "At that time, there came to Jesus one of the rulers of the synagogue, "; !strings.HasPrefix(p[0].Content, expected) {
+			t.Errorf("The first verse should start with %#v but is %#v.", expected, p[0].Content)
+		}
+		if expected := "Then while He was still speaking, some came from the ruler of the synagogue's house who said, \"Your daughter is dead.\""; p[2].Content != expected {
+			t.Errorf("Mark 5.35 should be %#v but is %#v.", expected, p[2].Content)
+		}
+	})
+
+	t.Run("Suffix", func(t *testing.T) {
+		p := passage(t, factory, 2018, 9, 16, "Matt 25.14-30")
+
+		if expected := "The Lord said this parable: The kingdom of heaven is like a man traveling to a far country, who called his own servants and delivered his goods to them."; p[0].Content != expected {
+			t.Errorf("The first verse should be %#v but is %#v.", expected, p[0].Content)
+		}
+		if expected := "And cast the unprofitable servant into the outer darkness. There will be weeping and gnashing of teeth. He who has ears to hear, let him hear."; p[1].Content != expected {
+			t.Errorf("The last verse should be %#v but is %#v.", expected, p[1].Content)
+		}
+	})
+
+	t.Run("Raw", func(t *testing.T) {
+		p := passage(t, raw, 2018, 9, 16, "Matt 25.14-30")
+
+		for i, verse := range p {
+			if verse != incipitBible["Matt 25.14-30"][i] {
+				t.Errorf("Raw scripture should not be changed but got %#v.", verse)
+			}
+		}
+	})
+
+	t.Run("Unchanged Bible", func(t *testing.T) {
+		passage(t, factory, 2018, 9, 16, "Matt 25.14-30")
+
+		if content := incipitBible["Matt 25.14-30"][0].Content; content[:3] != "For" {
+			t.Errorf("The passage from the bible should not be changed but is %#v.", content)
+		}
+	})
+}
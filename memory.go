@@ -0,0 +1,387 @@
+package orthocal
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed sql/*.sql
+var sqlFiles embed.FS
+
+var embeddedStore struct {
+	once  sync.Once
+	store *MemoryStore
+	err   error
+}
+
+// MemoryStore is a CalendarStore that holds the entire calendar in memory.
+// It is safe for concurrent use.
+type MemoryStore struct {
+	commemorations []CommemorationRecord
+	readings       []ReadingRecord
+	pericopes      map[PericopeKey]PericopeRecord
+	composites     map[int]CompositeRecord
+
+	// Indexes into commemorations and readings
+	commemorationsByPDist map[int][]int
+	commemorationsByDate  map[MonthDay][]int
+	readingsByPDist       map[int][]int
+	readingsByDate        map[MonthDay][]int
+}
+
+// NewMemoryStore returns a MemoryStore holding the calendar data that is
+// embedded in the package. The data is only parsed once, so subsequent calls
+// return the same store.
+func NewMemoryStore() (*MemoryStore, error) {
+	embeddedStore.once.Do(func() {
+		embeddedStore.store, embeddedStore.err = LoadMemoryStore(sqlFiles)
+	})
+
+	return embeddedStore.store, embeddedStore.err
+}
+
+// LoadMemoryStore builds a MemoryStore from the days.sql, readings.sql,
+// pericopes.sql, composites.sql and composite_references.sql files in the sql
+// directory of fsys. The files must be in the format of the ones in this
+// package's sql directory.
+func LoadMemoryStore(fsys fs.FS) (*MemoryStore, error) {
+	var self MemoryStore
+
+	self.pericopes = make(map[PericopeKey]PericopeRecord)
+	self.composites = make(map[int]CompositeRecord)
+	self.commemorationsByPDist = make(map[int][]int)
+	self.commemorationsByDate = make(map[MonthDay][]int)
+	self.readingsByPDist = make(map[int][]int)
+	self.readingsByDate = make(map[MonthDay][]int)
+
+	loaders := []struct {
+		file    string
+		columns int
+		load    func(values []sqlValue)
+	}{
+		{"sql/days.sql", 13, self.loadCommemoration},
+		{"sql/readings.sql", 9, self.loadReading},
+		{"sql/pericopes.sql", 11, self.loadPericope},
+		{"sql/composites.sql", 5, self.loadCompositeVerse},
+		{"sql/composite_references.sql", 6, self.loadCompositeReference},
+	}
+
+	for _, loader := range loaders {
+		data, e := fs.ReadFile(fsys, loader.file)
+		if e != nil {
+			return nil, e
+		}
+
+		rows, e := parseInserts(string(data))
+		if e != nil {
+			return nil, fmt.Errorf("%s: %w", loader.file, e)
+		}
+
+		for i, row := range rows {
+			if len(row) != loader.columns {
+				return nil, fmt.Errorf("%s: insert %d has %d values but should have %d", loader.file, i+1, len(row), loader.columns)
+			}
+			loader.load(row)
+		}
+	}
+
+	return &self, nil
+}
+
+func (self *MemoryStore) loadCommemoration(v []sqlValue) {
+	// pdist, month, day, title, subtitle, feast_name, feast_level, service,
+	// service_note, saint, fast, fast_exception, flag
+	r := CommemorationRecord{
+		PDist:         v[0].Int(),
+		Month:         v[1].Int(),
+		Day:           v[2].Int(),
+		Title:         v[3].String(),
+		Subtitle:      v[4].String(),
+		FeastName:     v[5].String(),
+		FeastLevel:    v[6].Int(),
+		ServiceNote:   v[8].String(),
+		Saint:         v[9].String(),
+		Fast:          v[10].Int(),
+		FastException: v[11].Int(),
+	}
+
+	i := len(self.commemorations)
+	self.commemorations = append(self.commemorations, r)
+	self.commemorationsByPDist[r.PDist] = append(self.commemorationsByPDist[r.PDist], i)
+	date := MonthDay{r.Month, r.Day}
+	self.commemorationsByDate[date] = append(self.commemorationsByDate[date], i)
+}
+
+func (self *MemoryStore) loadReading(v []sqlValue) {
+	// month, day, pdist, source, desc, book, pericope, ordering, flag
+	r := ReadingRecord{
+		Month:       v[0].Int(),
+		Day:         v[1].Int(),
+		PDist:       v[2].Int(),
+		Source:      v[3].String(),
+		Description: v[4].String(),
+		Book:        v[5].String(),
+		Pericope:    v[6].String(),
+		Ordering:    v[7].Int(),
+	}
+
+	i := len(self.readings)
+	self.readings = append(self.readings, r)
+	self.readingsByPDist[r.PDist] = append(self.readingsByPDist[r.PDist], i)
+	date := MonthDay{r.Month, r.Day}
+	self.readingsByDate[date] = append(self.readingsByDate[date], i)
+}
+
+func (self *MemoryStore) loadPericope(v []sqlValue) {
+	// pericope, book, display, sdisplay, desc, preverse, prefix, prefixb,
+	// verses, suffix, flag
+	var r PericopeRecord
+	r.Pericope = v[0].String()
+	r.Book = v[1].String()
+	r.Display = v[2].String()
+	r.ShortDisplay = v[3].String()
+	r.Description = v[4].String()
+	r.Preverse = v[5].String()
+	r.Prefix = v[6].String()
+	r.PrefixB = v[7].String()
+	r.Verses = v[8].String()
+	r.Suffix = v[9].String()
+
+	// Like a SQL query, the first matching row wins.
+	if _, exists := self.pericopes[r.PericopeKey]; !exists {
+		self.pericopes[r.PericopeKey] = r
+	}
+}
+
+func (self *MemoryStore) loadCompositeVerse(v []sqlValue) {
+	// composite_num, book, chapter, verse, reading
+	num := v[0].Int()
+	record := self.composites[num]
+	record.Num = num
+	record.Verses = append(record.Verses, Verse{
+		Book:    compositeBook(v[1].String()),
+		Chapter: uint16(v[2].Int()),
+		Verse:   uint16(v[3].Int()),
+		Content: v[4].String(),
+	})
+	self.composites[num] = record
+}
+
+func (self *MemoryStore) loadCompositeReference(v []sqlValue) {
+	// composite_num, book, start_chapter, start_verse, end_chapter, end_verse
+	num := v[0].Int()
+	record := self.composites[num]
+	record.Num = num
+	record.References = append(record.References, CompositeReference{
+		Book:         compositeBook(v[1].String()),
+		StartChapter: v[2].Int(),
+		StartVerse:   v[3].Int(),
+		EndChapter:   v[4].Int(),
+		EndVerse:     v[5].Int(),
+	})
+	self.composites[num] = record
+}
+
+func (self *MemoryStore) Commemorations(ctx context.Context, pdists []int, dates []MonthDay) ([]CommemorationRecord, error) {
+	if e := ctx.Err(); e != nil {
+		return nil, e
+	}
+
+	var records []CommemorationRecord
+	for _, i := range lookupIndexes(self.commemorationsByPDist, self.commemorationsByDate, pdists, dates) {
+		records = append(records, self.commemorations[i])
+	}
+
+	return records, nil
+}
+
+func (self *MemoryStore) Readings(ctx context.Context, pdists []int, dates []MonthDay) ([]ReadingRecord, error) {
+	if e := ctx.Err(); e != nil {
+		return nil, e
+	}
+
+	var records []ReadingRecord
+	for _, i := range lookupIndexes(self.readingsByPDist, self.readingsByDate, pdists, dates) {
+		records = append(records, self.readings[i])
+	}
+
+	return records, nil
+}
+
+func (self *MemoryStore) Pericopes(ctx context.Context, keys []PericopeKey) ([]PericopeRecord, error) {
+	if e := ctx.Err(); e != nil {
+		return nil, e
+	}
+
+	var records []PericopeRecord
+	for _, key := range keys {
+		if r, ok := self.pericopes[key]; ok {
+			records = append(records, r)
+		}
+	}
+
+	return records, nil
+}
+
+func (self *MemoryStore) Composite(ctx context.Context, num int) (CompositeRecord, error) {
+	if e := ctx.Err(); e != nil {
+		return CompositeRecord{Num: num}, e
+	}
+
+	// Copy the slices so that callers cannot change the store
+	record := self.composites[num]
+	record.Num = num
+	record.References = append([]CompositeReference(nil), record.References...)
+	record.Verses = append([]Verse(nil), record.Verses...)
+
+	return record, nil
+}
+
+// Collect the indexes of the records matching any of the pdists or dates.
+// The indexes are returned in the order of the records in the data set.
+func lookupIndexes(byPDist map[int][]int, byDate map[MonthDay][]int, pdists []int, dates []MonthDay) []int {
+	var indexes []int
+
+	seen := make(map[int]bool)
+	add := func(found []int) {
+		for _, i := range found {
+			if !seen[i] {
+				indexes = append(indexes, i)
+				seen[i] = true
+			}
+		}
+	}
+
+	for _, pdist := range pdists {
+		add(byPDist[pdist])
+	}
+	for _, date := range dates {
+		// Movable records have no date
+		if date.Month != 0 {
+			add(byDate[date])
+		}
+	}
+
+	sort.Ints(indexes)
+	return indexes
+}
+
+// SQL parsing
+
+// A sqlValue is a literal from an insert statement. NULL is represented by
+// the zero value.
+type sqlValue struct {
+	text string
+}
+
+func (self sqlValue) String() string {
+	return self.text
+}
+
+func (self sqlValue) Int() int {
+	i, _ := strconv.Atoi(self.text)
+	return i
+}
+
+// Parse the values of each "insert into ... values(...);" statement in the
+// SQL. Other statements and comments are ignored. Only the subset of SQL
+// used by the files in the sql directory is supported.
+func parseInserts(sql string) ([][]sqlValue, error) {
+	var rows [][]sqlValue
+
+	for {
+		start := strings.Index(sql, "insert into ")
+		if start < 0 {
+			break
+		}
+
+		open := strings.Index(sql[start:], "values(")
+		if open < 0 {
+			return nil, fmt.Errorf("malformed insert at %q", truncate(sql[start:], 40))
+		}
+		sql = sql[start+open+len("values("):]
+
+		row, rest, e := parseValues(sql)
+		if e != nil {
+			return nil, e
+		}
+		rows = append(rows, row)
+		sql = rest
+	}
+
+	return rows, nil
+}
+
+// Parse a comma separated list of literals ending with a closing parenthesis.
+// Returns the values and the remainder of the SQL.
+func parseValues(sql string) ([]sqlValue, string, error) {
+	var values []sqlValue
+
+	for {
+		sql = strings.TrimLeft(sql, " \t\r\n")
+		if len(sql) == 0 {
+			return nil, sql, fmt.Errorf("unexpected end of insert")
+		}
+
+		var value sqlValue
+		if sql[0] == '\'' {
+			// A quoted string in which '' is an escaped quote
+			var b strings.Builder
+			i := 1
+			for {
+				if i >= len(sql) {
+					return nil, sql, fmt.Errorf("unterminated string")
+				}
+				if sql[i] == '\'' {
+					if i+1 < len(sql) && sql[i+1] == '\'' {
+						b.WriteByte('\'')
+						i += 2
+						continue
+					}
+					break
+				}
+				b.WriteByte(sql[i])
+				i++
+			}
+			value.text = b.String()
+			sql = sql[i+1:]
+		} else {
+			end := strings.IndexAny(sql, ",)")
+			if end < 0 {
+				return nil, sql, fmt.Errorf("unterminated insert at %q", truncate(sql, 40))
+			}
+			literal := strings.TrimSpace(sql[:end])
+			if !strings.EqualFold(literal, "null") {
+				if _, e := strconv.Atoi(literal); e != nil {
+					return nil, sql, fmt.Errorf("unsupported literal %q", literal)
+				}
+				value.text = literal
+			}
+			sql = sql[end:]
+		}
+		values = append(values, value)
+
+		sql = strings.TrimLeft(sql, " \t\r\n")
+		switch {
+		case strings.HasPrefix(sql, ","):
+			sql = sql[1:]
+		case strings.HasPrefix(sql, ")"):
+			return values, sql[1:], nil
+		default:
+			return nil, sql, fmt.Errorf("expected , or ) at %q", truncate(sql, 40))
+		}
+	}
+}
+
+func truncate(s string, length int) string {
+	if len(s) > length {
+		return s[:length]
+	}
+	return s
+}
@@ -0,0 +1,123 @@
+package orthocal_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestMemoryStore(t *testing.T) {
+	db, e := sql.Open("sqlite3", "oca_calendar.db")
+	if e != nil {
+		t.Errorf("Got error opening database: %#v.", e)
+	}
+
+	store, e := orthocal.NewMemoryStore()
+	if e != nil {
+		t.Fatalf("Got error loading the embedded calendar: %#v.", e)
+	}
+
+	// The memory store should produce the same calendar as the database
+	for _, useJulian := range []bool{false, true} {
+		sqliteFactory := orthocal.NewDayFactory(useJulian, true, db)
+		memoryFactory := orthocal.NewDayFactoryWithStore(useJulian, true, store)
+
+		for _, year := range []int{2018, 2019, 2024} {
+			expected, e := sqliteFactory.NewYearCalendar(context.Background(), year, nil)
+			if e != nil {
+				t.Fatalf("Got error building %d from the database: %#v.", year, e)
+			}
+
+			actual, e := memoryFactory.NewYearCalendar(context.Background(), year, nil)
+			if e != nil {
+				t.Fatalf("Got error building %d from memory: %#v.", year, e)
+			}
+
+			for i := range expected {
+				if !reflect.DeepEqual(expected[i].Readings, actual[i].Readings) || !reflect.DeepEqual(expected[i].Saints, actual[i].Saints) || !reflect.DeepEqual(expected[i].Titles, actual[i].Titles) || !reflect.DeepEqual(expected[i].Feasts, actual[i].Feasts) {
+					t.Errorf("%d/%d/%d from memory differs from the database.", expected[i].Month, expected[i].Day, expected[i].Year)
+				}
+			}
+		}
+	}
+
+	t.Run("Composites", func(t *testing.T) {
+		sqliteStore := orthocal.NewSQLiteStore(db)
+		for num := 1; num <= 25; num++ {
+			expected, _ := sqliteStore.Composite(context.Background(), num)
+			actual, _ := store.Composite(context.Background(), num)
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("Composite %d from memory differs from the database.", num)
+			}
+		}
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, e := store.Readings(ctx, []int{0}, nil)
+		if e != context.Canceled {
+			t.Errorf("Expected context.Canceled but got %#v.", e)
+		}
+	})
+}
+
+func TestLoadMemoryStore(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/days.sql": {Data: []byte(`
+			create table if not exists days (pdist smallint);
+			insert into days values(0, 0, 0, 'Pascha', '', 'Pascha', 8, 0, '', '', 0, 11, 0);
+			insert into days values(999, 1, 1, '', '', 'St. Basil''s Day', 6, 0, '', 'St Basil', 0, 0, 0);
+		`)},
+		"sql/readings.sql": {Data: []byte(`
+			insert into readings values(0, 0, 0, 'Gospel', '', 'John', '1', 911, 0);
+		`)},
+		"sql/pericopes.sql": {Data: []byte(`
+			insert into pericopes values('1', 'John', 'John 1.1-17', 'John 1.1-17', 'Pascha', '', 'In the beginning', '', '', '', 0);
+		`)},
+		"sql/composites.sql": {Data: []byte(`
+			insert into composites values(1, 'Isa', 12, 3, 'Multi
+line');
+		`)},
+		"sql/composite_references.sql": {Data: []byte(`
+			insert into composite_references values(1, 'Isa', 55, 1, 55, 1);
+			insert into composite_references values(1, 'Isa', 12, 3, 12, 4);
+			insert into composite_references values(1, 'Isa', 63, 15, 64, 5);
+			insert into composite_references values(1, 'Isa', 40, 0, 40, 0);
+		`)},
+	}
+
+	store, e := orthocal.LoadMemoryStore(fsys)
+	if e != nil {
+		t.Fatalf("Got error loading the calendar: %#v.", e)
+	}
+
+	records, _ := store.Commemorations(context.Background(), nil, []orthocal.MonthDay{{1, 1}})
+	if len(records) != 1 || records[0].FeastName != "St. Basil's Day" {
+		t.Errorf("Got incorrect commemorations for 1/1: %#v.", records)
+	}
+
+	composite, _ := store.Composite(context.Background(), 1)
+	expected := []orthocal.Verse{{Book: "ISA", Chapter: 12, Verse: 3, Content: "Multi\nline"}}
+	if !reflect.DeepEqual(composite.Verses, expected) {
+		t.Errorf("Got incorrect composite: %#v.", composite.Verses)
+	}
+
+	var refs []string
+	for _, r := range composite.References {
+		refs = append(refs, r.String())
+	}
+	if !reflect.DeepEqual(refs, []string{"Isa 55.1", "Isa 12.3-4", "Isa 63.15-64.5", "Isa 40"}) {
+		t.Errorf("Got incorrect composite references: %#v.", refs)
+	}
+
+	fsys["sql/readings.sql"] = &fstest.MapFile{Data: []byte(`insert into readings values(0, 0, 'Gospel');`)}
+	if _, e := orthocal.LoadMemoryStore(fsys); e == nil {
+		t.Errorf("Expected an error for an insert with the wrong number of values.")
+	}
+}
@@ -0,0 +1,155 @@
+package orthocal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Reading selection
+//
+// The store returns every reading that might belong to a day. These rules
+// decide which of them actually do. Rules that can be expressed as data
+// belong in exceptions.json instead.
+
+// A readingSelector decides which of the reading records belong to a day.
+type readingSelector struct {
+	day *Day
+
+	ePDist, gPDist int
+	floatIndex     int
+	matinsGospel   int
+
+	// The daily readings of another day that are read on this one
+	transferred      int // pdist of the other day or 499
+	tePDist, tgPDist int
+}
+
+func (self *DayFactory) newReadingSelector(day *Day) readingSelector {
+	var s readingSelector
+
+	s.day = day
+	s.ePDist, s.gPDist = self.getAdjustedPDists(day)
+	s.floatIndex = day.pyear.LookupFloatIndex(day.PDist)
+	s.matinsGospel = matinsGospel(day)
+
+	// Daily readings transferred from a day on which they are suppressed
+	s.transferred = day.pyear.LookupTransferredReadings(day.PDist)
+	if s.transferred != 499 {
+		s.tePDist, s.tgPDist = self.dailyPDists(day.pyear, s.transferred)
+	}
+
+	return s
+}
+
+// The pdists that readings might be stored under.
+func (self readingSelector) pdists() []int {
+	pdists := []int{self.gPDist, self.ePDist, self.day.PDist}
+	if self.floatIndex != 499 {
+		pdists = append(pdists, self.floatIndex)
+	}
+	if self.matinsGospel != 0 {
+		pdists = append(pdists, self.matinsGospel+700)
+	}
+	if self.transferred != 499 {
+		pdists = append(pdists, self.tgPDist, self.tePDist)
+	}
+
+	return pdists
+}
+
+// The dates that readings might be stored under.
+func (self readingSelector) dates() []MonthDay {
+	return []MonthDay{{self.day.Month, self.day.Day}}
+}
+
+// Returns the index of the case that the record matches or -1 if it does
+// not belong to the day. The index is used to break ties in the ordering.
+func (self readingSelector) match(r ReadingRecord) int {
+	switch {
+	// The daily Gospel and Epistle, adjusted for the Lucan jump, etc.
+	case r.PDist == self.gPDist && r.Source == "Gospel":
+		return 0
+	case r.PDist == self.ePDist && r.Source == "Epistle":
+		return 1
+
+	// Everything else for the day in the Paschal cycle
+	case r.PDist == self.day.PDist && r.Source != "Epistle" && r.Source != "Gospel":
+		return 2
+
+	// Floating feasts
+	case self.floatIndex != 499 && r.PDist == self.floatIndex:
+		return 3
+
+	// The Sunday Matins Gospel from the cycle of 11
+	case self.matinsGospel != 0 && r.PDist == self.matinsGospel+700:
+		return 4
+
+	// The fixed calendar
+	case r.Month == self.day.Month && r.Day == self.day.Day:
+		return 5
+
+	// Daily readings transferred from another day
+	case self.transferred != 499 && r.PDist == self.tgPDist && r.Source == "Gospel":
+		return 6
+	case self.transferred != 499 && r.PDist == self.tePDist && r.Source == "Epistle":
+		return 7
+	}
+
+	return -1
+}
+
+// Whether a match is one of the transferred daily readings
+func (self readingSelector) isTransfer(rank int) bool {
+	return rank == 6 || rank == 7
+}
+
+// Whether the Vespers readings of a match are read on the evening of the day
+// rather than the evening before. Vespers is joined to the Liturgy on the
+// weekdays of Lent and Holy Week, including Holy Saturday, and on the eves of
+// Nativity and Theophany, and the paremias of the next day are moved to the
+// evening. Feasts of the Paschal cycle, such as Ascension, have their
+// paremias on the evening before like any other feast. Paremias that an
+// exception moves to an earlier day are read on its evening too.
+func (self readingSelector) isEvening(rank int) bool {
+	pdist := self.day.PDist
+	if rank == 2 && pdist >= -48 && pdist <= -1 {
+		weekday := WeekDayFromPDist(pdist)
+		return (weekday >= Monday && weekday <= Friday) || pdist == -1
+	}
+
+	return (self.day.Month == 12 && self.day.Day == 24) || (self.day.Month == 1 && self.day.Day == 5)
+}
+
+// Whether a Vespers reading added by an exception is read on the evening of
+// the day. A reading added on a date is treated like one from the fixed
+// calendar and any other like one stored under the day's pdist.
+func (self readingSelector) isAddedEvening(r Reading) bool {
+	rank := 2
+	if r.fixed {
+		rank = 5
+	}
+
+	return r.Source == "Vespers" && self.isEvening(rank)
+}
+
+// Service notes describing readings that are read on another day
+func (self readingSelector) serviceNotes() []string {
+	var notes []string
+
+	if self.transferred != 499 {
+		weekday := time.Weekday(WeekDayFromPDist(self.transferred))
+		notes = append(notes, fmt.Sprintf("The daily readings for %s are read today", weekday))
+	}
+
+	if to := self.day.pyear.LookupReadingsTransfer(self.day.PDist); to != 499 {
+		weekday := time.Weekday(WeekDayFromPDist(to))
+		notes = append(notes, fmt.Sprintf("The daily readings for today are read on %s", weekday))
+	}
+
+	return notes
+}
+
+type rankedReading struct {
+	ReadingRecord
+	rank int
+}
@@ -0,0 +1,282 @@
+package orthocal
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// References
+//
+// Pericopes are displayed as lists of verse ranges such as "Matt 6.31-34,
+// 7.9-11" or "Isa 7.10-16; 8.1-4, 9-10". A number after a comma continues
+// the chapter of the previous range unless that range was whole chapters.
+// After a semicolon a number without a verse is a chapter. The lectionary
+// sometimes starts a new chapter of the same book after a comma and
+// sometimes after a semicolon, so the separator is kept when a reference is
+// parsed.
+
+var ErrInvalidReference = errors.New("orthocal: invalid reference")
+
+// A Reference is a range of verses within a book of the Bible. Book is the
+// USFM code of the book. A verse of 0 means the whole chapter. StartPart and
+// EndPart hold the letter of a half-verse, e.g. b for 14b.
+//
+// Separator, BookName and Remark keep how a parsed reference was written so
+// that it is formatted the same way. They are empty where it was written as
+// it would be formatted anyway.
+type Reference struct {
+	Book         string `json:"book"`
+	StartChapter int    `json:"start_chapter"`
+	StartVerse   int    `json:"start_verse"`
+	StartPart    string `json:"start_part,omitempty"`
+	EndChapter   int    `json:"end_chapter"`
+	EndVerse     int    `json:"end_verse"`
+	EndPart      string `json:"end_part,omitempty"`
+
+	Separator string `json:"-"` // before the reference, e.g. "; " before 8.1-4 in Isa 7.10-16; 8.1-4
+	BookName  string `json:"-"` // e.g. 4[2] Kings
+	Remark    string `json:"-"` // after the reference, e.g. (LXX)
+}
+
+var (
+	compositePrefixRe = regexp.MustCompile(`^Composite\s+\d+\s*-\s*`)
+	remarkRe          = regexp.MustCompile(`\s*(\([^()]*\))\s*$`)
+	referenceBookRe   = regexp.MustCompile(`^((?:\d\s*(?:\[\d\]\s*)?)?[A-Za-z][A-Za-z. ]*?)\.?\s*(\d.*)$`)
+	referenceRangeRe  = regexp.MustCompile(`^(\d+)(?:[.:](\d+))?([ab])?(?:\s*[-–]\s*(\d+)(?:[.:](\d+))?([ab])?)?$`)
+	bookAlternateRe   = regexp.MustCompile(`\s*\[\d\]\s*`)
+)
+
+// ParseReference parses a reference in the style of the pericope display or
+// short display, e.g. "Matthew 6.31-34, 7.9-11" or "1 Cor 5.6-8; Gal 3.13-14".
+// The prefix of a composite reading is ignored. A trailing remark such as
+// (LXX) is kept in the Remark of the last reference.
+func ParseReference(reference string) ([]Reference, error) {
+	text := compositePrefixRe.ReplaceAllLiteralString(strings.TrimSpace(reference), "")
+
+	var remark string
+	if groups := remarkRe.FindStringSubmatch(text); groups != nil {
+		remark = groups[1]
+		text = text[:len(text)-len(groups[0])]
+	}
+
+	var references []Reference
+	var book string
+
+	for i, group := range strings.Split(text, ";") {
+		group = strings.TrimSpace(group)
+
+		var separator, bookName string
+		if groups := referenceBookRe.FindStringSubmatch(group); groups != nil && len(strings.Trim(groups[1], " .")) > 0 {
+			name := bookAlternateRe.ReplaceAllLiteralString(groups[1], " ")
+			if book = NormalizeBookName(name); len(book) == 0 {
+				return nil, fmt.Errorf("%w: unknown book %#v in %#v", ErrInvalidReference, strings.TrimSpace(groups[1]), reference)
+			}
+			group = groups[2]
+
+			if name = strings.TrimSpace(groups[1]); !isBookName(book, name) {
+				bookName = name
+			}
+		} else if i > 0 {
+			// A new chapter of the same book
+			separator = "; "
+		}
+
+		if len(book) == 0 {
+			return nil, fmt.Errorf("%w: no book in %#v", ErrInvalidReference, reference)
+		}
+
+		// The first number of a group is a chapter. Later ones are chapters
+		// only if the previous range was whole chapters.
+		chapter, chapters := 0, true
+
+		for j, item := range strings.Split(group, ",") {
+			groups := referenceRangeRe.FindStringSubmatch(strings.TrimSpace(item))
+			if groups == nil {
+				return nil, fmt.Errorf("%w: cannot parse %#v in %#v", ErrInvalidReference, strings.TrimSpace(item), reference)
+			}
+
+			r := Reference{Book: book}
+			if j == 0 {
+				r.Separator, r.BookName = separator, bookName
+			}
+			first, _ := strconv.Atoi(groups[1])
+
+			switch {
+			case len(groups[2]) > 0:
+				r.StartChapter = first
+				r.StartVerse, _ = strconv.Atoi(groups[2])
+			case isSingleChapter(book):
+				r.StartChapter, r.StartVerse = 1, first
+			case chapters:
+				r.StartChapter = first
+			default:
+				r.StartChapter, r.StartVerse = chapter, first
+			}
+			r.StartPart = groups[3]
+
+			second, _ := strconv.Atoi(groups[4])
+			switch {
+			case len(groups[4]) == 0:
+				r.EndChapter, r.EndVerse, r.EndPart = r.StartChapter, r.StartVerse, r.StartPart
+			case len(groups[5]) > 0:
+				r.EndChapter = second
+				r.EndVerse, _ = strconv.Atoi(groups[5])
+				r.EndPart = groups[6]
+			case r.StartVerse == 0:
+				r.EndChapter = second
+			default:
+				r.EndChapter, r.EndVerse, r.EndPart = r.StartChapter, second, groups[6]
+			}
+
+			if e := r.validate(); e != nil {
+				return nil, fmt.Errorf("%w: %s in %#v", ErrInvalidReference, e, reference)
+			}
+
+			references = append(references, r)
+			chapter, chapters = r.EndChapter, r.EndVerse == 0
+		}
+	}
+
+	if len(references) == 0 {
+		return nil, fmt.Errorf("%w: %#v is empty", ErrInvalidReference, reference)
+	}
+	references[len(references)-1].Remark = remark
+
+	return references, nil
+}
+
+func (self Reference) validate() error {
+	switch {
+	case self.StartChapter == 0:
+		return fmt.Errorf("chapter 0")
+	case (self.StartVerse == 0) != (self.EndVerse == 0):
+		return fmt.Errorf("a range from a chapter to a verse")
+	case self.StartVerse == 0 && (len(self.StartPart) > 0 || len(self.EndPart) > 0):
+		return fmt.Errorf("half of a chapter")
+	case self.EndChapter < self.StartChapter:
+		return fmt.Errorf("a range that ends before it starts")
+	case self.EndChapter == self.StartChapter && self.EndVerse < self.StartVerse:
+		return fmt.Errorf("a range that ends before it starts")
+	}
+
+	if book, ok := LookupBook(self.Book); ok && self.EndChapter > book.Chapters {
+		return fmt.Errorf("%s has %d chapters", book.Name(LectionaryNames), book.Chapters)
+	}
+
+	return nil
+}
+
+// String formats the reference in the style of the short display, e.g.
+// Matt 6.31-34.
+func (self Reference) String() string {
+	return FormatShortDisplay([]Reference{self})
+}
+
+// FormatDisplay formats references with full book names, e.g. "Matthew
+// 6.31-34, 7.9-11".
+func FormatDisplay(references []Reference) string {
+	return FormatReferences(references, LectionaryNames, false)
+}
+
+// FormatShortDisplay formats references with abbreviated book names, e.g.
+// "Matt 6.31-34, 7.9-11".
+func FormatShortDisplay(references []Reference) string {
+	return FormatReferences(references, LectionaryNames, true)
+}
+
+// FormatReferences formats references with the book names of the given
+// style.
+func FormatReferences(references []Reference, style BookNameStyle, abbreviated bool) string {
+	var b strings.Builder
+
+	for i, r := range references {
+		if i > 0 && r.Book == references[i-1].Book {
+			separator := ", "
+			if len(r.Separator) > 0 {
+				separator = r.Separator
+			}
+			b.WriteString(separator)
+
+			// Continue the chapter of the previous range, which is given again
+			// after a semicolon
+			previous := references[i-1]
+			if r.StartVerse != 0 && previous.EndVerse != 0 && r.StartChapter == previous.EndChapter && separator == ", " {
+				b.WriteString(r.formatRange(false))
+			} else {
+				b.WriteString(r.formatRange(!isSingleChapter(r.Book) || r.StartChapter != 1 || chapterNumbered[r.Book]))
+			}
+		} else {
+			if i > 0 {
+				b.WriteString("; ")
+			}
+
+			name := bookDisplayName(r.Book, style, abbreviated)
+			if sameName(r.BookName, name) {
+				name = r.BookName
+			}
+			b.WriteString(name)
+			b.WriteString(" ")
+			b.WriteString(r.formatRange(!isSingleChapter(r.Book) || r.StartChapter != 1 || r.StartVerse == 0 || chapterNumbered[r.Book]))
+		}
+
+		if len(r.Remark) > 0 {
+			b.WriteString(" ")
+			b.WriteString(r.Remark)
+		}
+	}
+
+	return b.String()
+}
+
+// The lectionary gives the chapter of these books even though they have only
+// one, e.g. 3 John 1.1-14 but Jude 1-10.
+var chapterNumbered = map[string]bool{"2JN": true, "3JN": true}
+
+// Whether the name is one of the names of the book in the lectionary
+func isBookName(book, name string) bool {
+	b, ok := LookupBook(book)
+	if !ok {
+		return true
+	}
+
+	for _, style := range []BookNameStyle{LectionaryNames, OrthodoxNames, ProtestantNames} {
+		if name == b.Name(style) || name == b.Abbreviation(style) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Whether a book name as written is a spelling of the name, e.g. 4[2] Kings
+// for 4 [2] Kings
+func sameName(written, name string) bool {
+	return len(written) > 0 && strings.ReplaceAll(written, " ", "") == strings.ReplaceAll(name, " ", "")
+}
+
+// Format the range without the book, with or without its starting chapter.
+func (self Reference) formatRange(withChapter bool) string {
+	if self.StartVerse == 0 {
+		if self.EndChapter != self.StartChapter {
+			return fmt.Sprintf("%d-%d", self.StartChapter, self.EndChapter)
+		}
+		return strconv.Itoa(self.StartChapter)
+	}
+
+	s := fmt.Sprintf("%d%s", self.StartVerse, self.StartPart)
+	if withChapter {
+		s = fmt.Sprintf("%d.%s", self.StartChapter, s)
+	}
+
+	switch {
+	case self.EndChapter != self.StartChapter:
+		s += fmt.Sprintf("-%d.%d%s", self.EndChapter, self.EndVerse, self.EndPart)
+	case self.EndVerse != self.StartVerse || self.EndPart != self.StartPart:
+		s += fmt.Sprintf("-%d%s", self.EndVerse, self.EndPart)
+	}
+
+	return s
+}
@@ -0,0 +1,231 @@
+package orthocal_test
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestParseReference(t *testing.T) {
+	type R = orthocal.Reference
+
+	tests := map[string][]R{
+		"Matt 6.31-34, 7.9-11": {
+			{Book: "MAT", StartChapter: 6, StartVerse: 31, EndChapter: 6, EndVerse: 34},
+			{Book: "MAT", StartChapter: 7, StartVerse: 9, EndChapter: 7, EndVerse: 11},
+		},
+		"Mark 5.22-24, 35-6.1": {
+			{Book: "MRK", StartChapter: 5, StartVerse: 22, EndChapter: 5, EndVerse: 24},
+			{Book: "MRK", StartChapter: 5, StartVerse: 35, EndChapter: 6, EndVerse: 1},
+		},
+		"Matt 26:40-27:2": {
+			{Book: "MAT", StartChapter: 26, StartVerse: 40, EndChapter: 27, EndVerse: 2},
+		},
+		"1 Corinthians 5.6-8; Galatians 3.13-14": {
+			{Book: "1CO", StartChapter: 5, StartVerse: 6, EndChapter: 5, EndVerse: 8},
+			{Book: "GAL", StartChapter: 3, StartVerse: 13, EndChapter: 3, EndVerse: 14},
+		},
+		"Isa 7.10-16; 8.1-4, 9-10": {
+			{Book: "ISA", StartChapter: 7, StartVerse: 10, EndChapter: 7, EndVerse: 16},
+			{Book: "ISA", StartChapter: 8, StartVerse: 1, EndChapter: 8, EndVerse: 4, Separator: "; "},
+			{Book: "ISA", StartChapter: 8, StartVerse: 9, EndChapter: 8, EndVerse: 10},
+		},
+		"Composite 6 - Exodus 12, 13; Numbers 8; Leviticus 12": {
+			{Book: "EXO", StartChapter: 12, EndChapter: 12},
+			{Book: "EXO", StartChapter: 13, EndChapter: 13},
+			{Book: "NUM", StartChapter: 8, EndChapter: 8},
+			{Book: "LEV", StartChapter: 12, EndChapter: 12},
+		},
+		"3 [1] Kings 19.19, 20; 4[2] Kings 2.1,6-14": {
+			{Book: "1KI", StartChapter: 19, StartVerse: 19, EndChapter: 19, EndVerse: 19},
+			{Book: "1KI", StartChapter: 19, StartVerse: 20, EndChapter: 19, EndVerse: 20},
+			{Book: "2KI", StartChapter: 2, StartVerse: 1, EndChapter: 2, EndVerse: 1, BookName: "4[2] Kings"},
+			{Book: "2KI", StartChapter: 2, StartVerse: 6, EndChapter: 2, EndVerse: 14},
+		},
+		"Jude 1-10": {
+			{Book: "JUD", StartChapter: 1, StartVerse: 1, EndChapter: 1, EndVerse: 10},
+		},
+		"John 3.16b-18a": {
+			{Book: "JHN", StartChapter: 3, StartVerse: 16, StartPart: "b", EndChapter: 3, EndVerse: 18, EndPart: "a"},
+		},
+		"Job 42.12-17 (LXX)": {
+			{Book: "JOB", StartChapter: 42, StartVerse: 12, EndChapter: 42, EndVerse: 17, Remark: "(LXX)"},
+		},
+	}
+
+	for reference, expected := range tests {
+		t.Run(reference, func(t *testing.T) {
+			references, e := orthocal.ParseReference(reference)
+			if e != nil {
+				t.Fatalf("Got error parsing %#v: %#v.", reference, e)
+			}
+			if !reflect.DeepEqual(references, expected) {
+				t.Errorf("%#v should be %#v but got %#v.", reference, expected, references)
+			}
+		})
+	}
+
+	for _, reference := range []string{"", "Matt", "Foo 1.2", "Matt 5.10-3", "Matt 5.x", "Matt 0.1"} {
+		t.Run("Invalid "+reference, func(t *testing.T) {
+			_, e := orthocal.ParseReference(reference)
+			if !errors.Is(e, orthocal.ErrInvalidReference) {
+				t.Errorf("Parsing %#v should fail with ErrInvalidReference but got %#v.", reference, e)
+			}
+		})
+	}
+
+	t.Run("Pericopes", func(t *testing.T) {
+		db, e := sql.Open("sqlite3", "oca_calendar.db")
+		if e != nil {
+			t.Fatalf("Got error opening database: %#v.", e)
+		}
+
+		rows, e := db.Query("select display, sdisplay from pericopes")
+		if e != nil {
+			t.Fatalf("Got error querying pericopes: %#v.", e)
+		}
+		defer rows.Close()
+
+		// These are described rather than given as references
+		unparsable := map[string]bool{
+			"Jeremiah (Baruch 3.35-4.4)":                        true,
+			"Ezekiel 1.21-28 (-2.1 LXX)":                        true,
+			"Daniel 3.1-23; Song of the Three 1-66 with verses": true,
+		}
+
+		for rows.Next() {
+			var display, sdisplay string
+			if e := rows.Scan(&display, &sdisplay); e != nil {
+				t.Fatalf("Got error scanning pericopes: %#v.", e)
+			}
+
+			for _, reference := range []string{display, sdisplay} {
+				if _, e := orthocal.ParseReference(reference); e != nil && !unparsable[reference] {
+					t.Errorf("Got error parsing %#v: %#v.", reference, e)
+				}
+			}
+		}
+	})
+}
+
+func TestFormatReferences(t *testing.T) {
+	tests := map[string]string{
+		"Matthew 6.31-34, 7.9-11":                          "Matt 6.31-34, 7.9-11",
+		"Mark 5.22-24, 35-6.1":                             "Mark 5.22-24, 35-6.1",
+		"1 Corinthians 5.6-8; Galatians 3.13-14":           "1 Cor 5.6-8; Gal 3.13-14",
+		"Exodus 12, 13; Numbers 8; Leviticus 12":           "Exod 12, 13; Num 8; Lev 12",
+		"3 [1] Kings 19.19, 20, 21; 4 [2] Kings 2.1, 6-14": "3 Kgs 19.19, 20, 21; 4 Kgs 2.1, 6-14",
+		"Jude 1-10":                        "Jude 1-10",
+		"John 3.16b-18a":                   "John 3.16b-18a",
+		"Isaiah 63.15-64.5, 8-9":           "Isa 63.15-64.5, 8-9",
+		"Isaiah 7.10-16; 8.1-4, 9-10":      "Isa 7.10-16; 8.1-4, 9-10",
+		"Micah 4.2-3, 5; 6.2-5, 8; 5.4, 5": "Micah 4.2-3, 5; 6.2-5, 8; 5.4, 5",
+		"Job 38.1-23; 42.1-5":              "Job 38.1-23; 42.1-5",
+		"3 John 1.1-14":                    "3 John 1.1-14",
+		"Ezekiel 1.21-28 (-2.1 LXX)":       "Ezek 1.21-28 (-2.1 LXX)",
+	}
+
+	for display, sdisplay := range tests {
+		t.Run(display, func(t *testing.T) {
+			for _, reference := range []string{display, sdisplay} {
+				references, e := orthocal.ParseReference(reference)
+				if e != nil {
+					t.Fatalf("Got error parsing %#v: %#v.", reference, e)
+				}
+
+				if actual := orthocal.FormatDisplay(references); actual != display {
+					t.Errorf("%#v should be displayed as %#v but got %#v.", reference, display, actual)
+				}
+				if actual := orthocal.FormatShortDisplay(references); actual != sdisplay {
+					t.Errorf("%#v should be displayed as %#v but got %#v.", reference, sdisplay, actual)
+				}
+			}
+		})
+	}
+
+	t.Run("Spelling", func(t *testing.T) {
+		references, _ := orthocal.ParseReference("4[2] Kings 2.6-14")
+		if actual := orthocal.FormatDisplay(references); actual != "4[2] Kings 2.6-14" {
+			t.Errorf("4[2] Kings 2.6-14 should be displayed as it is written but got %#v.", actual)
+		}
+		if actual := orthocal.FormatShortDisplay(references); actual != "4 Kgs 2.6-14" {
+			t.Errorf("4[2] Kings 2.6-14 should be displayed as 4 Kgs 2.6-14 but got %#v.", actual)
+		}
+	})
+
+	t.Run("Pericopes", func(t *testing.T) {
+		db, e := sql.Open("sqlite3", "oca_calendar.db")
+		if e != nil {
+			t.Fatalf("Got error opening database: %#v.", e)
+		}
+
+		rows, e := db.Query("select display, sdisplay from pericopes")
+		if e != nil {
+			t.Fatalf("Got error querying pericopes: %#v.", e)
+		}
+		defer rows.Close()
+
+		// The punctuation of the pericopes is not consistent
+		normalize := func(reference string) string {
+			reference = regexp.MustCompile(`^Composite \d+ - `).ReplaceAllLiteralString(reference, "")
+			reference = regexp.MustCompile(`,(\S)`).ReplaceAllString(reference, ", $1")
+			return strings.Replace(reference, ":", ".", -1)
+		}
+
+		// These are written differently than they would be formatted
+		irregular := map[string]string{
+			"Matthew 26.2-20; John 13.3-17; Matt 26.21-39; Luke 22.43-45; Matt 26.40-27.2": "Matthew 26.2-20; John 13.3-17; Matthew 26.21-39; Luke 22.43-45; Matthew 26.40-27.2",
+			"Matthew 27.1-38; Luke 23.39-43; Matt 27.39-54; John 19.31-37; Matt 27.55-61":  "Matthew 27.1-38; Luke 23.39-43; Matthew 27.39-54; John 19.31-37; Matthew 27.55-61",
+			"Acts 9.10-9.19":                       "Acts 9.10-19",
+			"Titus 1.1-4, 2.15-3.3, 3.12-15":       "Titus 1.1-4, 2.15-3.3, 12-15",
+			"3 [1] Kings 7.51-8.1, 8.4-7, 9-11":    "3 [1] Kings 7.51-8.1, 4-7, 9-11",
+			"3 Kgs 7.51-8.1, 8.4-7, 9-11":          "3 Kgs 7.51-8.1, 4-7, 9-11",
+			"1 Peter 1.1-2, 10-12, 2.6-10 (short)": "1 Pet 1.1-2, 10-12, 2.6-10",
+			"Ezekiel 1.1-20 (short)":               "Ezek 1.1-20",
+		}
+
+		for rows.Next() {
+			var display, sdisplay string
+			if e := rows.Scan(&display, &sdisplay); e != nil {
+				t.Fatalf("Got error scanning pericopes: %#v.", e)
+			}
+
+			for _, format := range []struct {
+				reference string
+				short     bool
+			}{{display, false}, {sdisplay, true}} {
+				references, e := orthocal.ParseReference(format.reference)
+				if e != nil {
+					continue
+				}
+
+				expected, actual := normalize(format.reference), orthocal.FormatDisplay(references)
+				if format.short {
+					actual = orthocal.FormatShortDisplay(references)
+					if x, ok := irregular[expected+" (short)"]; ok {
+						expected = x
+					}
+				}
+				if x, ok := irregular[expected]; ok {
+					expected = x
+				}
+
+				if actual != expected {
+					t.Errorf("%#v should be formatted as %#v but got %#v.", format.reference, expected, actual)
+				}
+			}
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		r := orthocal.Reference{Book: "MAT", StartChapter: 26, StartVerse: 40, EndChapter: 27, EndVerse: 2}
+		if r.String() != "Matt 26.40-27.2" {
+			t.Errorf("%#v should be Matt 26.40-27.2 but got %#v.", r, r.String())
+		}
+	})
+}
@@ -0,0 +1,101 @@
+create table if not exists composite_references (
+  composite_num tinyint default null,
+  book text default null,
+  start_chapter tinyint default null,
+  start_verse tinyint default null,
+  end_chapter tinyint default null,
+  end_verse tinyint default null
+);
+
+create index composite_references_num on composite_references(composite_num);
+
+-- The references that make up each composite reading, in order. A verse of 0
+-- means that only the chapter is known. The references follow the display of
+-- the pericope even where the fixed translation in the composites table adds or
+-- leaves out a verse, as in composites 1, 10, 12 and 20.
+
+insert into composite_references values(1, 'Gen', 17, 1, 17, 2);
+insert into composite_references values(1, 'Gen', 17, 4, 17, 4);
+insert into composite_references values(1, 'Gen', 17, 5, 17, 7);
+insert into composite_references values(1, 'Gen', 17, 8, 17, 8);
+insert into composite_references values(1, 'Gen', 17, 9, 17, 10);
+insert into composite_references values(1, 'Gen', 17, 11, 17, 12);
+insert into composite_references values(1, 'Gen', 17, 14, 17, 14);
+insert into composite_references values(2, 'Prov', 10, 0, 10, 0);
+insert into composite_references values(2, 'Prov', 3, 0, 3, 0);
+insert into composite_references values(2, 'Prov', 8, 0, 8, 0);
+insert into composite_references values(3, 'Wis', 4, 0, 4, 0);
+insert into composite_references values(3, 'Wis', 5, 0, 5, 0);
+insert into composite_references values(4, 'Prov', 10, 0, 10, 0);
+insert into composite_references values(4, 'Wis', 6, 0, 6, 0);
+insert into composite_references values(4, 'Wis', 7, 0, 7, 0);
+insert into composite_references values(4, 'Wis', 8, 0, 8, 0);
+insert into composite_references values(4, 'Wis', 9, 0, 9, 0);
+insert into composite_references values(5, 'Wis', 4, 0, 4, 0);
+insert into composite_references values(5, 'Wis', 6, 0, 6, 0);
+insert into composite_references values(5, 'Wis', 7, 0, 7, 0);
+insert into composite_references values(5, 'Wis', 2, 0, 2, 0);
+insert into composite_references values(6, 'Exod', 12, 0, 12, 0);
+insert into composite_references values(6, 'Exod', 13, 0, 13, 0);
+insert into composite_references values(6, 'Num', 8, 0, 8, 0);
+insert into composite_references values(6, 'Lev', 12, 0, 12, 0);
+insert into composite_references values(7, 'Isa', 19, 1, 19, 1);
+insert into composite_references values(7, 'Isa', 19, 3, 19, 3);
+insert into composite_references values(7, 'Isa', 19, 4, 19, 5);
+insert into composite_references values(7, 'Isa', 19, 12, 19, 12);
+insert into composite_references values(7, 'Isa', 19, 16, 19, 16);
+insert into composite_references values(7, 'Isa', 19, 19, 19, 21);
+insert into composite_references values(8, 'Isa', 40, 0, 40, 0);
+insert into composite_references values(8, 'Isa', 41, 0, 41, 0);
+insert into composite_references values(8, 'Isa', 45, 0, 45, 0);
+insert into composite_references values(8, 'Isa', 48, 0, 48, 0);
+insert into composite_references values(8, 'Isa', 54, 0, 54, 0);
+insert into composite_references values(9, 'Malachi', 3, 0, 3, 0);
+insert into composite_references values(9, 'Malachi', 4, 0, 4, 0);
+insert into composite_references values(10, 'Gen', 17, 15, 17, 17);
+insert into composite_references values(10, 'Gen', 17, 19, 17, 19);
+insert into composite_references values(10, 'Gen', 18, 11, 18, 14);
+insert into composite_references values(10, 'Gen', 21, 1, 21, 8);
+insert into composite_references values(11, 'Judges', 13, 2, 13, 8);
+insert into composite_references values(11, 'Judges', 13, 13, 13, 14);
+insert into composite_references values(11, 'Judges', 13, 17, 13, 18);
+insert into composite_references values(11, 'Judges', 13, 21, 13, 21);
+insert into composite_references values(12, '3 Kgs', 17, 1, 17, 23);
+insert into composite_references values(13, '3 Kgs', 18, 0, 18, 0);
+insert into composite_references values(13, '3 Kgs', 19, 0, 19, 0);
+insert into composite_references values(14, '3 Kgs', 19, 19, 19, 19);
+insert into composite_references values(14, '3 Kgs', 19, 20, 19, 20);
+insert into composite_references values(14, '3 Kgs', 19, 21, 19, 21);
+insert into composite_references values(14, '4 Kgs', 2, 1, 2, 1);
+insert into composite_references values(14, '4 Kgs', 2, 6, 2, 14);
+insert into composite_references values(15, 'Exod', 33, 11, 33, 23);
+insert into composite_references values(15, 'Exod', 34, 4, 34, 6);
+insert into composite_references values(15, 'Exod', 34, 8, 34, 8);
+insert into composite_references values(16, 'Isa', 63, 15, 64, 5);
+insert into composite_references values(16, 'Isa', 64, 8, 64, 9);
+insert into composite_references values(17, 'Exod', 40, 1, 40, 5);
+insert into composite_references values(17, 'Exod', 40, 9, 40, 10);
+insert into composite_references values(17, 'Exod', 40, 16, 40, 16);
+insert into composite_references values(17, 'Exod', 40, 34, 40, 35);
+insert into composite_references values(18, '3 Kgs', 7, 51, 8, 1);
+insert into composite_references values(18, '3 Kgs', 8, 4, 8, 7);
+insert into composite_references values(18, '3 Kgs', 8, 9, 8, 11);
+insert into composite_references values(19, 'Micah', 4, 2, 4, 3);
+insert into composite_references values(19, 'Micah', 4, 5, 4, 5);
+insert into composite_references values(19, 'Micah', 6, 2, 6, 5);
+insert into composite_references values(19, 'Micah', 6, 8, 6, 8);
+insert into composite_references values(19, 'Micah', 5, 4, 5, 4);
+insert into composite_references values(19, 'Micah', 5, 5, 5, 5);
+insert into composite_references values(20, 'Isa', 55, 1, 55, 1);
+insert into composite_references values(20, 'Isa', 12, 3, 12, 4);
+insert into composite_references values(20, 'Isa', 55, 2, 55, 13);
+insert into composite_references values(21, 'Isa', 62, 10, 63, 3);
+insert into composite_references values(21, 'Isa', 63, 7, 63, 9);
+insert into composite_references values(22, 'Zech', 14, 1, 14, 1);
+insert into composite_references values(22, 'Zech', 14, 4, 14, 4);
+insert into composite_references values(22, 'Zech', 14, 8, 14, 11);
+insert into composite_references values(23, '3 Kgs', 19, 3, 19, 9);
+insert into composite_references values(23, '3 Kgs', 19, 11, 19, 13);
+insert into composite_references values(23, '3 Kgs', 19, 15, 19, 15);
+insert into composite_references values(23, '3 Kgs', 19, 16, 19, 16);
+insert into composite_references values(24, 'Lev', 26, 0, 26, 0);
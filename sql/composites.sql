@@ -1,5 +1,8 @@
 create table if not exists composites (
   composite_num tinyint default null,
+  book text default null,
+  chapter tinyint default null,
+  verse tinyint default null,
   reading text default null
 );
 
@@ -7,28 +10,182 @@ create index composite_num on composites(composite_num);
 
 -- The following translations are by Archimandrite Ephrem Lash of blessed memory.
 -- Fr. Ephrem's translations can be found at https://github.com/brianglass/anastasis
+--
+-- Each row is one verse, in the order in which the verses are read. The verses
+-- of composites 2, 3, 4, 5, 6, 8, 9, 13 and 24 have not been identified, so the
+-- whole reading is in a single row with the first chapter and verse 0.
 
-insert into composites values(1, 'The Lord appeared to Abram and said to him, ‘I am your God.  Be well pleasing before me and be blameless.  I will establish my covenant between me and you, and I will multiply you greatly; and you will be the father of a multitude of nations.  And your name will not be Abram, but your name will be Abraham, because I have established you as father of many nations.  And I will increase you very greatly, and I will establish you for nations, and kings will come from you.  And I will establish my covenant between me and you and between your seed after you for their generations as an eternal covenant, and I will be their God.’  And Abraham fell on his face and worshipped the Lord.  And God said to Abraham, ‘You are to keep my covenant, you and your seed after you to their generations.  And this is the covenant which you are to keep between me and between your seed after you to their generations.  Every male among you shall be circumcised; and you shall be circumcised in the foreskin of your flesh, and it shall be for a sign of the covenant between me and you.  And every male child among you shall be circumcised at eight days for your generations.  And an uncircumcised male that is not circumcised in the flesh of his foreskin on the eighth day, that soul shall be wiped out from its race; because it has rejected my covenant.’');
-insert into composites values(2, 'The memory of a just man is praised, and the Lord’s blessing is upon his head. Blessed is one who has found wisdom; a mortal who knows understanding. To import her is better than treasures of gold and silver. She is more valuable than precious stones; nothing of value equals her worth. Justice proceeds from her mouth; she bears law and mercy on her tongue. Therefore, my children, listen to me, for I speak weighty things. And blessed is the one who keeps my ways. For my goings out are the goings out of life, and favour is prepared from the Lord. Therefore I exhort you, and utter my voice to the children of humankind. Because I, Wisdom, have prepared counsel, knowledge and understanding. I have called on them. Counsel and sureness are mine; prudence is mine, strength is mine. I love those who are my friends, while those who seek me will find grace. You innocent, then, understand cunning; you untaught, take it to heart. Listen to me, for I will speak weighty things, and I will open right things from my lips. Because my throat will meditate truth; lying lips are abominable before me. All the words of my mouth are with justice, there is nothing crooked in them nor twisted. They are all straight for those who understand, and right for those who find knowledge. For I teach you what is true, that your hope may be in the Lord and that you may be filled with spirit.');
-insert into composites values(3, 'A just man if he comes to his end will be at rest. A just man who dies will condemn the ungodly who are alive; for they will see the end of a just man and will not understand what they counselled concerning him. For the Lord will break the ungodly, render them voiceless and cast them headlong, and he will shake them from the foundations and they will be utterly worsted in sorrow, and their memory shall perish. They shall come with fear at the accounting of their sins, and their iniquities will convict them to their face. Then the just will stand with much boldness in the face of those who afflicted him and made his toils of no account. When they see this they will be troubled with great fear and will be amazed at the wonder of his salvation. For they will say as they repent and with anguish they will groan and say: Is this he whom we fools once made a laughing stock and a byword of reproach? We reckoned his life folly and his end dishonour. How has he been numbered among the children of God and his lot with the Saints? Therefore we have erred from the way of truth and the light of righteousness has not shone on us and the sun has not dawned on us. We have been filled with paths of lawlessness and destruction and journeyed through trackless paths, but have not known the way of the Lord.');
-insert into composites values(4, 'The mouth of a just man distils wisdom; the lips of men know graces.  The mouth of the wise meditates wisdom; justice delivers them from death.  When a just man dies hope is not lost; for a just son is born for life, and among his good things he will pluck the fruit of justice.  There is light at all times for the just, and they will find grace and glory from the Lord.  The tongue of the wise knows what is good, and wisdom will take its rest in their hearts.  The Lord loves holy hearts; while all who are blameless in the way are acceptable to him.  The wisdom of the Lord will enlighten the face of the understanding; for she anticipates those who desire her before they know it, and is easily contemplated by those who love her.  One who rises for her at dawn will not toil, and one who keeps vigil because of her will be without care.  For she goes about seeking those who are worthy of her, and shows herself favourably to those on her paths.  Wickedness will never prevail against wisdom.  Because of this I too became a lover of her beauty and became her friend, and I sought her out from my youth, and I sought to take her as my bride,  because the Master of all things loved her,  for she is an initiate of the knowledge of God and one who chooses his works.  Her toils are virtues; she herself teaches sobriety and prudence; justice and courage, than which things nothing is more useful in human life.  If anyone longs for much experience, she knows how to compare things of old and those that are to come.  She knows the twists of words and the explanations of riddles. She foresees signs and wonders and the outcomes of seasons and times.  And to all she is a good counsellor.  Because immortality is in her, and fame in the fellowship of her words.  Therefore I appealed to the Lord and besought him and said from my whole heart, ‘God of my Fathers and Lord of mercy, who made all things by your Word, and established humanity by your Wisdom to be sovereign over the creatures that had come into being by you, and to order the world in holiness and justice, give me Wisdom who sits by your throne, and do not reject me from among your children, for I am your servant and the son of your maid servant.  Send her out from your holy dwelling and from the throne of your glory, that she may be present with me and teach me what is well pleasing before you.  And she will guide me with knowledge and guard me with her glory.  For all the thoughts of mortals are wretched and their ideas are unstable.’');
-insert into composites values(5, 'When a just man is praised, peoples will be glad; for immortality is his memory, because it is known both to God and humankind, and his soul is pleasing to the Lord. Therefore, O men, desire wisdom, and long for it and be instructed. For her beginning is love and keeping of laws. Honour Wisdom, that you may reign for ever. I will declare the mysteries of God to you and not hide them from you. Because he is both the guide of Wisdom and the One who sets right the wise. In his hand are all prudence, and knowledge of works. Wisdom, the artificer of all things, taught me, for in her is an understanding spirit, holy, brightness of the eternal light and image of the goodness of God. She makes friends of God and prophets. She is more lovely than the sun, and beyond every order of the stars. If compared to light, she is found before it. She delivered her devotees from toils, and guided them in straight paths. She gave them holy knowledge and protected them from those who lay in ambush for them. She awarded them a mighty contest, that all might know that true religion is more powerful than all, and that wickedness can never prevail against Wisdom, nor will justice in passing sentence overlook evil people. For they said in themselves, not reasoning correctly, ‘Let us overpower the just one, let us not spare his saintliness, nor feel reverence for the aged grey hairs of an elder. Let our strength be law. Let us lie in ambush for the just, for he is a hindrance to us and he is opposed to our works and alleges the sins of our upbringing. He claims to have knowledge of God, and calls himself a child of the Lord. He has become a reproof to our thoughts. He is grievous for us even to look at, for his life is not like others and his paths are quite different. We are reckoned by him to be counterfeit, and he keeps away from our ways as from filth. He calls the end of just people blessed. Let us see if his words are true, and let us test what will happen to him at the end. Let us examine him by insult and torture, that we may know his forbearance and make trial of his patience. Let us condemn him to a shameful death, for he will have protection from his own words.’ This was how they argued, and they were deceived, for their wickedness had blinded them. And they did not know the mysteries of God, nor did they judge that you alone are God, who have authority over life and death, who save in time of tribulation and deliver from every ill; pitying and merciful, giving grace to your holy ones, and by your right arm resisting the proud.');
-insert into composites values(6, 'The Lord spoke to Moses on the day on which he brought the children of Israel out of the land of Egypt, saying, ‘Sanctify to me every first-born, first produced that opens every womb among the children of Israel’. And Moses said to the people, ‘Remember this day, on which you came out of Egypt, from the house of slavery, for the Lord has brought you out from there with a mighty hand. And keep his law. And it shall be that when the Lord God brings you into the land of the Chananites, in the way that he swore to your fathers, you shall set apart everything that opens the womb; the males to the Lord. But if after this your son asks you, saying, “What is this?”, you will say to him, “God brought us out of Egypt, from the house of slavery, with a mighty hand. And when Pharao hardened his heart against sending us out, the Lord slew every first-born in the land of Egypt, from the first-born of humans to the first-born of animals. This is why I sacrifice everything that opens the womb; the males to the Lord, and every first-born of my sons I will redeem”. And it will be for a sign upon your hand, and immovable before your eyes, because thus said the Lord the Almighty, “All the first-born of your sons you will give me. And it shall be that everyone who gives birth to a male child shall circumcise the flesh of its foreskin on the eighth day. And for thirty three days he will not come into the sanctuary of God to the Priest, until the days of purification are completed. And after this he will offer an unblemished yearling lamb to the Lord for a holocaust, and a young pigeon or turtle dove to the Priest at the door of the Tabernacle of Witness. Or instead of these he will offer two young pigeons or two turtle doves. And the Priest will make atonement for him. Because these have been offered to me as an offering out of all the children of Israel. And I have taken them and sanctified them for myself in place of the first-born of the Egyptians, on the day when I smote every first-born in the land of Egypt from human to beast of burden,” said God the Most High, the Holy One of Israel.’');
-insert into composites values(7, 'See, the Lord will be seated on a light cloud and will come to Egypt and the idols of Egypt will be shaken at his presence and their heart will be worsted within them. ‘And their spirit will be troubled within them, and I will frustrate their counsel and hand Egypt over into the hands of harsh lords,’ says the Lord Sabaoth. And the Egyptians will drink water that is beside the sea, while the river will fail and be dried up. Thus says the Lord, ‘Where now are your wise men? And let them declare to you and let them say, “What has the Lord Sabaoth planned against Egypt?” On that day the Egyptians will be like women, in fear and trembling in the presence of the hand of the Lord Sabaoth, which he will bring against them. And there will be an Altar to the Lord in the country of the Egyptians and a pillar to Lord at its border. And it will be for a sign for ever to the Lord in the country of Egypt, because they will cry to the Lord and he will send them a man who will save them. And the Lord will be known to the Egyptians. And the Egyptians will know the Lord in that day, and they will offer sacrifice and gift, and they will vow vows to the Lord and pay them.');
-insert into composites values(8, 'Thus says the Lord: Comfort, comfort my people, says God. Priests, speak to the heart of Jerusalem. Comfort her, because her humiliation has been completed; for her has sin has been abolished, because she has received from the Lord’s hand double for her sins. A voice of one crying in the wilderness: Prepare the way of the Lord, make straight the paths of our God. Every valley will be filled and every mountain and hill made low; what is crooked will become straight, and the rough ways will be made smooth; and all flesh shall see the salvation of God. Go up onto a high mountain, you who bring good tidings to Sion; lift up your voice with strength, you who bring good tidings to Jerusalem. Lift it up, do not be afraid. I the Lord God, I, the God of Israel, will hearken and will not forsake them; but I will open rivers from the mountains and springs in the middle of plains. I will turn the wilderness into water meadows and the thirsty earth with water courses. Let the heavens rejoice from on high and let the clouds rain justice. Let the earth sprout and blossom with mercy and justice. Announce a voice of gladness to the end of the earth and let this be heard: Say that the Lord has delivered his servant Jacob. And if they thirst through deserts, he will bring water for them from a rock. Rejoice you barren who have never given birth, break out and shout, you who have never known birth pangs, for the children of the deserted are more than those of her who has a husband.');
-insert into composites values(9, 'Thus says the Lord Almighty: See, I am sending my Angel, my messenger, before your face, who will prepare your way before you. And the Lord whom you seek will come to his temple. And who will endure the day of his entrance? And who will withstand at his appearing? Because he will enter like fire in a smelting furnace and like the lye of launderers. And he will come to you in judgement; and he will be a swift witness against the wicked and against adulteresses and against those swear falsely in his name and those who do not fear him, says the Lord Almighty. Because I am the Lord your God, and I have not changed and you, children of Jacob, have perverted the laws and not kept them. Therefore turn back to me and I will turn back to you, says the Lord Almighty. And all the nations will call you blessed and you will know that I am the Lord who discern between just and lawless on the day on which I make a peculiar possession of those who love me. Know then and remember the law of Moses my servant, as I gave him commandment on Horeb, to all Israel ordinances and judgements. And see, I will send you Elias the Thesbite, before the great and manifest day of the Lord comes; he will turn again the heart of father to son and of a man to his neighbour, lest when I come I smite the earth grievously, says the Lord Almighty, God the Holy One of Israel.');
-insert into composites values(10, 'The Lord God said to Abraham: As for Sara your wife, her name shall not be called Sara, but Sarra shall be her name. I will bless her and will give you a child from her; and I will bless it and it shall be for nations and kings of nations will come from it. And Abraham fell on his face, and laughed and said in his mind; Shall a son be born in my hundredth year? And shall Sarra who is ninety bear a child? God said to Abraham: Yes; see, your wife Sarra will bear you a son and you will call his name Isaac; and I will establish my covenant with him as an everlasting covenant. Now Abraham and Sarra were old, advanced in years. But Sarra laughed to herself, saying: The thing has not happened to me until now; and my lord is old. And the Lord God said to Abraham: Why did Sarra laugh to herself, saying: Shall I really give birth? For I am aged. But nothing is impossible for God. And the Lord visited Sarra, as He had said, and she conceived and bore a son to Abraham in their old age at the time the Lord had said to him. But he circumcised him on the eighth day, as the Lord God had commanded him. And Abraham was a hundred when Isaac his son was born to him. But Sarra said: The Lord has given me laughter; for whoever hears will rejoice with me. And she said: Who will announce to Abraham that Sarra is suckling a child, for I have born a child in my old age? And the child grew and was weaned. And Abraham held a great banquet on the day his son Isaac was weaned.');
-insert into composites values(11, 'In those days there was a man of the tribe of Dan and his name was Manoe, and his wife was barren and had not borne a child. And the Angel of the Lord appeared to his wife and said to her: See, you are barren and have not borne a child or conceived a son. But now take care, and do not drink wine or strong drink, and eat nothing unclean. Because see, you will conceive in the womb and bear a son, and no iron shall touch his head, because the child is to be a Nazarite to God from his mother’s womb. And the woman came and spoke to her husband, saying: A Man of God came to me, and his appearance was as the appearance on an Angel of God, exceedingly bright. And he said: See, you will conceive in the womb and bear a son; and now take care, and drink no wine or strong drink, and eat nothing unclean, for the child shall be a Nazarite of God from the womb to the day of his death. And Manoe besought the Lord and said: My Lord, let the man of God, whom You sent, come again to us, and enlighten us what we should do for the child that is to be born. Then the Angel came to Manoe and said: Of all the things that I said to your wife, let her take care. She is not to eat anything that comes from the vine; she is not to drink wine or strong drink. And Manoe said to the Angel of the Lord: What is your name? That when your word comes to pass, we may glorify you. And the Angel of the Lord said to him: Why do you ask my name? For it is wonderful. And the Angel of the Lord appeared no more to Manoe and his wife.');
-insert into composites values(12, 'The word of the Lord came to the Prophet Elias and he said to Achab, ‘As the Lord the God of powers lives, the God of Israel, before whom I stand today, there shall be neither dew nor rain during these years, except by my mouth.’ The word of the Lord came to Elias, saying, ‘Go from here and towards the east, and hide yourself in the brook Chorrath, which is opposite the Jordan. You shall drink from the brook, and I am commanding the ravens to feed you there.’ So he went and settled by the brook Chorrath, which is opposite the Jordan. The ravens brought him bread in the morning, and meat in the evening; and he drank water from the brook. And it cane to pass after some days that the brook dried up, because there was no rain on the land. Then the word of the Lord came to Elias, saying, ‘Arise and go to Sarepta, which belongs to Sidon, and settle there; for see, I am commanding a widow there to feed you.’ And he arose and went to Sarepta, to the gate of the city. And a widow was there gathering sticks. And Elias he called after her and said, ‘Bring me a little water in a vessel, so that I may drink.’ As she was going to bring it, he called after her and said, ‘Bring me a morsel of bread in your hand.’ But the woman said, ‘As the Lord your God lives, I have nothing baked, only a handful of flour in the jar, and a little oil in the jug; I am now gathering a couple of sticks, so that I may go home and prepare it for myself and my children, that we may eat it, and die.’ Elias said to her, ‘Take courage. Go and do as you have said; but first make me a little cake of it and bring it to me, and afterwards make something for yourself and your children. For thus says the Lord the God of Israel: The jar of flour will not fail and the jug of oil will not grow less until the day that the Lord sends rain on the whole land.’ The woman went and did as Elias said, and he and she and her children ate. And from that day the jar of flour did not fail, neither did the jug of oil grow less, according to the word of the Lord that he spoke by Elias. After this the son of the woman, the mistress of the house, became ill; his illness was so severe that there was no breath left in him. She then said to Elias, ‘Why do you trouble me, man of God? Have you come to me to bring my sins to remembrance, and to cause the death of my son?’ But he said to her, ‘Give me your son.’ He took him from her bosom, carried him up into the upper chamber where he was lodging, and laid him on his own bed. He cried out to the Lord, ‘Alas, Lord my God, you have brought calamity upon the widow with whom I am staying, whose witness you are, by killing her son.’ Then he breathed upon the child three times, and called on the Lord and said, ‘Lord my God, let this child’s life come into him again.’ And so it happened and he cried out. And the Lord listened to the voice of Elias; the child’s soul came into him again, and he lived. Elias took the child, brought him down from the upper chamber into the house, and gave him to his mother. Then Elias said, ‘See, your son is alive.’ So the woman said to Elias, ‘Now I know that you are a man of God, and that the word of the Lord in your mouth is true.’');
-insert into composites values(13, 'The word of the Lord came to Elias the Thesbite in the third year, saying, ‘Go, and appear before Achab, and I will give rain on the face of the land. And it came to pass that when Achab saw Elias, he said to him, ‘Is it you, the one who is troubling Israel?’ He answered, ‘I am not troubling Israel; but you are, and your father’s house, by forsaking the Lord our God and following Baal. Now therefore have all Israel assemble to me at Mount Carmel, with the four hundred fifty prophets of Baal and the four hundred prophets of the scared groves, who eat at Jezebel’s table.’ So Achab sent to all Israel, and assembled the prophets at Mount Carmel. Elias said to them, ‘How long will you go limping with two different opinions? If the Lord is God, follow him; but if Baal, then follow him.’ Then Elias said to the people, ‘I, even I only, am left a prophet of the Lord; but the prophets of the sacred grove are very many. Let two bulls be given to us; let them choose one bull for themselves, cut it in pieces, and lay it on the wood, but put no fire to it; I will prepare the other bull, but put no fire to it. Then you call on the name of your god and I will call on the name of the Lord my God. And the god who answers by fire shall be God.’ All the people answered, ‘The word you have spoken today is good.’ Then Elias said to the prophets of shame, ‘Choose for yourselves one calf and prepare it first; then call on the name of your god, but put no fire to it.’ So they took the calf, prepared it, and called on the name of Baal from morning until noon, crying, ‘O Baal, hear us!’ But there was no voice, and no answer. They ran upon the altar that they had made. At noon Elias the Thesbite mocked them, saying, ‘Cry aloud! For your god likes garrulousness.’ And when the time of the offering of the oblation came, there was nothing. Then Elias the Thesbite said to the prophets of abominations, ‘Stand aside now, and I will offer my holocaust’. And Elias said to the people, ‘Come close’. And all the people came closer to him. Elias took twelve stones, according to the number of the tribes of Israel, to whom the word of the Lord had come, saying, ‘Israel shall be your name’. With the stones he built and repaired the altar of the Lord that had been cast down. Then he made a trench around the altar, large enough to contain two measures of seed. Next he put the pieces of wood on the altar he had made, cut the holocaust in pieces, and laid them on the pieces of wood and piled them on the altar. He said, ‘Bring me two jars of water and pour it on the holocaust and on the pieces of wood.’ Then he said, ‘Do it a second time’; and they did it a second time. Again he said, ‘Do it a third time’; and they did it a third time, so that the water ran all around the altar, and filled the trench also with water. And the prophet Elias cried aloud to heaven and said, ‘Lord, God of Abraham, Isaac, and Israel, hear me today by fire. And let this people known that you alone the Lord. the God of Israel, that I am your servant, and that through you I have done all these things, and that you have turned back the heart of this people to you.’ Then fire from the Lord fell from heaven and consumed the holocaust and the pieces of wood; and the fire licked up the water that was in the trench, the stones, and the dust. And the people fell on their faces and said, ‘The Lord indeed is God; he is God.’ Elias said to them, ‘Seize the prophets of Baal; do not let one of them escape.’ Then they seized them; and Elias brought them down to the brook Kishon, and killed them there. And after this Elias said to Achab, ‘There is a sound of rushing rain. Harness your chariot and go down, lest the rain catch you.’ Then Elias went up to the top of Carmel; there he bowed himself down upon the earth and put his face between his knees and prayed to the Lord. And the heavens grew black with clouds and wind; there was a heavy rain. Achab went to Jezreel. Achab told Jezebel his wife all that Elias had done. Then Jezebel sent to Elias, saying, ‘Tomorrow I will sacrifice your life like one of them.’ And Elias heard and was afraid; he arose and fled for his life, and came to Beersheba, in the land of Juda; he left his servant there. But he himself went a day’s journey into the wilderness, and came and sat down under a solitary broom tree. Then he lay down under the broom tree and fell asleep. Suddenly someone touched him and said to him, ‘Arise and eat and drink, for you have a long journey.’ Elias looked, and there at his head was a cake of flour and a jar of water. He arose, ate and drank, and slept again. The angel of the Lord came a second time, touched him, and said, ‘Arise and eat and drink, for you have a long journey.’ He arose, and ate and drank; then he went in the strength of that food forty days and forty nights to mount Horeb. There he entered a cave, and spent the night there. Then the word of the Lord came to him, saying, ‘What are you doing here, Elias?’ Elias answered, ‘I have been very zealous for the Lord, the Almighty; for the children of Israel have forsaken your covenant, thrown down your altars, and killed your prophets with the sword. I alone am left, and they are seeking my life, to take it away.’ Then the Lord said to him, ‘Go, return to your way and you will come to the desert way of Damascus; and you shall anoint Elissaios son of Shaphat as prophet in your place.');
-insert into composites values(14, 'A day came and Elias found Elissaios son of Saphat, who was ploughing. Elias passed by him and threw his mantle over him. Elissaios left the oxen, ran after Elias, and ministered to him. And it came to pass, when the Lord took Elias in a whirlwind as though up to heaven, that Elias went with Elissaios to Galgala. Then Elias said to Elissaios, ‘Stay here; for the Lord has sent me as far as the Jordan.’ But he said, ‘As the Lord lives, and as you yourself live, I will not leave you.’ So the two of them went on. Fifty men of the sons of the prophets came, and stood at some distance from them, as they both were standing by the Jordan. Then Elias took his mantle and rolled it up, and struck the water with it; the water was parted to the one side and to the other, and the two of them crossed on dry ground. When they had crossed, Elias said to Elissaios, ‘Ask me what I may do for you, before I am taken up from you.’ Elissaios said, ‘Please let me inherit a double share of your spirit.’ He responded, ‘You have asked a hard thing; yet, if you see me as I am being taken up from you, it will be granted you; if not, it will not.’ It came to pass that as they continued walking and talking, a chariot of fire and horses of fire separated the two of them, and Elias was taken up in a whirlwind as if into heaven. Elissaios kept watching and crying out, ‘Father, father! The chariots of Israel and its horsemen!’ But when he could no longer see him, Elissaios grasped his own clothes and tore them in two pieces. He picked up the mantle of Elias that had fallen from him, and went back and stood on the bank of the Jordan. Elissaios took the mantle of Elias that had fallen from him, and struck the water, saying, ‘Where then is the God of Elias, Appho?’ And so  he struck the water, and the water was parted to the one side and to the other, and Elissaios went over on dry ground.');
-insert into composites values(15, 'The Lord spoke to Moses face to face, as one speaks to one’s friend. Then he would return to the camp; but the young servant, Jesus, son of Navi, did not leave the tent. Moses said to the Lord, ‘See, you say to me, “Bring up this people”; but you have not shown me whom you will send with me. Yet you have said to me, “I know you above all others, and you have also found favour in my sight.” Now if I have found favour in your sight, show yourself to me, so that I may see you and find favour in your sight, that I may know that this great nation is your people.‘ And the Lord said to him, ‘I myself will go before you, and I will give you rest.’ And he said to him, ‘If you will not go with us yourself, do not carry me up from here. For how shall it be truly known that I have found favour in your sight, I and your people, unless you go with us? In this way, we shall be glorified, I and your people, more than all the nations.’ The Lord said to Moses, ‘For you I will do this word that you have spoken; for you have found favour in my sight, and I know you above all others.’ Moses said, ‘Show me your own glory.’ And he said, ‘I will pass by you in my glory, and will proclaim before you my name, “The Lord”; and I will be have mercy on those on whom I will have mercy, and will have pity on those on whom I will have pity.’ And he said, ‘You cannot see my face; for no human shall see my face and live.’ And the Lord said, ‘See, there is a place by me; stand on the rock. And while my glory passes by I will put you in a cleft of the rock, and I will cover you with my hand until I have passed by; then I will take away my hand, and you shall see my back; but my face shall not be seen by you.’ So Moses rose early in the morning and went up on Mount Sina, as the Lord had commanded him. The Lord descended in the cloud and stood with him there, and proclaimed the name, ‘The Lord. The Lord passed before his face, and proclaimed, ‘The Lord, the Lord, God compassionate and merciful, slow to anger, and full of mercy and true’.  And Moses quickly bowed to the earth, and worshipped the Lord.');
-insert into composites values(16, 'Look down from heaven, Lord, and see from your holy house and your glory. Where is the multitude of your mercy and your pity, that you keep back from us, O Lord? For you are our Father, though Abraham did not know us, though Israel did not ackno­wledge us; but you are our Father, deliver us. From the beginning your name is upon us. Why have you made us wander from your way, Lord? Why have you hardened our hearts not to fear you? Turn back for the sake of your servants, for the tribes of your inheritance, that we may inherit a small part of your holy mountain. Our enemies have trampled down your sanctuary. We have become as at the beginning, when you did not know us, when your name had not been invoked upon us. If you open the heaven, trembling will take hold upon mountains from you, and they will melt as wax melts before the fire; and fire will burn up your enemies, and your name will be manifest to your enemies; before your face nations will be troubled. When you do glorious things trembling from you will seize mountains. From eternity we have not heard, nor have we seen a God save you; and your works are true, and you do mercy to those who wait on you. For mercy will meet those who act justly, and they will be mind­ful of your ways. And now, Lord, your are our Father, while we are your clay and you are our Fashioner; we are all the works of your hands; do not be very angry with us, Lord, and do not remember our sins in season. And now look upon, Lord, for we are all your people.');
--- Commposite 17 seems to be missing from Arch. Ephrem's work. The provided reference in pericopes.sql comes very close.
--- Commposite 18 seems to be missing from Arch. Ephrem's work. The provided reference in pericopes.sql comes very close.
-insert into composites values(19, 'Thus says the Lord: From Sion will come forth the Law and the Word of the Lord from Jerusalem. And he will judge among many peoples and rebuke mighty nations in a distant land. For all the peoples will walk, each its own way, while we will walk in the name of the Lord our God for ever. Thus says the Lord Almighty: Listen hills and valleys, foundations of the earth, because the Lord has a controversy with his people; for he will dispute with Israel, saying, ‘My people, what have I done to you? Or how have I grieved you? Or how have I troubled you? Answer me. For I brought you up out of the land of Egypt, and rescued you from the house of slavery, and sent Moses and Aaron before your face. My people, what have your enemies planned against you? Was it not told you, O man, what is good? And what does the Lord seek from you, except to execute judgement, and to love mercy and to be ready to walk with the Lord your God?’ Therefore the Lord will be magnified in strength, and will shepherd his flock in peace, to the extremities of the earth.');
-insert into composites values(20, 'Thus says the Lord: You who thirst, go to the water; and all who have no money, go, buy and eat and drink wine and fat without money or price. For thus says the Lord Almighty to you: My people, draw water with joy from the springs of salvation. And you will say in that day: Praise the Lord, cry his name aloud, declare his glory among the nations, call to mind that his name has been exalted. My people, hear me, and eat good things, and your soul will delight in good things. Attend with your ears and follow my ways. Listen to me and your soul will live among good things. And I will make an eternal covenant with you, and you will call upon me. And when you draw near me, let the impious abandon his ways, and the lawless man his plans; and turn back to me and I will have mercy on you and forgive your sins. For your plans are not as my plans, says the Lord; but as heaven is distant from the earth, so is my way distant from your ways, and your thoughts from my mind. For as rain or snow would come down from heaven and not return there, until it had soaked the earth, and it bring forth and bud and give seed to the sower, and bread for food, so shall my word be, which once it has come from my mouth will not return there until it has accomplished all that I willed; and I will make my ways and my commands succeed. For you will go out with joy, and be taught with gladness; for the mountains and hills will exult as they receive you with joy; and all the trees of the field will clap with their branches; and instead of the briar shall come up the cypress; instead of the nettle shall come up the myrtle; and the Lord shall be for a name and for an eternal sign, says the Lord God, the Holy One of Israel.');
-insert into composites values(21, 'Thus says the Lord: Walk, go through my gates; prepare my way and make a way for my people, and cast the stones out of the way; raise up a standard for the nations. For see, the Lord has made it heard to the ends of the earth: Say to the daughter of Sion: See, your Saviour has come, and his reward is with him, and his work before his face. And he will call it a holy people, redeemed by the Lord; while you will be called a city sought after, and not forsaken. Who is this who comes from Edom, the scarlet of his garments from Bosor, thus beautiful in his apparel? He cries out with much strength. I reason of justice and judgement of salvation. Why are your garments red, and your clothing as from a trodden winepress? I am full of the trodden grape; I have trampled the winepress quite alone, and no man from the nations was with me. I have remembered the mercy of the Lord, I will recall the Lord’s virtues, the Lord’s praise for all the things with which He rewards us. The Lord is a good judge for the house of Israel; he deals with us according to his mercy and according to the multitude of his justice. And he said: Are you not my people? Children will surely not be rebellious; and he became for them salvation out of their every distress. It was not an emissary, not an Angel, but the Lord himself saved them because he loved them and spared them. He redeemed them and took them up and exalted them all the days of the age.');
-insert into composites values(22, 'Thus says the Lord: See, the day of the Lord is coming, and on that day his feet will stand upon the mount of Olives, opposite Jerusalem, where the sun rises. And on that day living water will come out from Jerusalem, half towards the first sea and half towards the last sea; in spring and in summer it shall be so; and the Lord will be for a King over all the earth; in that day there shall be one Lord, and his name, compassing all the earth and the wilderness from Gabaa as far as Remmon, south of Jerusalem; and he shall be exalted and remain on his place from the gate of Benjamin as far as the place of the first gate, as far as the gate of Gomor and as far as the tower of Anameël and as far as the tower of the corners and as far as the king’s winepresses; they shall dwell in it and there shall be no more curse and Jerusalem shall dwell confidently.');
-insert into composites values(23, 'And Elias heard and was afraid; he arose and fled for his life, and came to Beersheba, in the land of Juda; he left his servant there. But he himself went a day’s journey into the wilderness, and came and sat down under a solitary broom tree. Then he lay down under the broom tree and fell asleep. Suddenly someone touched him and said to him, ‘Arise and eat and drink, for you have a long journey.’ Elias looked, and there at his head was a cake of flour and a jar of water. He arose, ate and drank, and slept again. The angel of the Lord came a second time, touched him, and said, ‘Arise and eat and drink, for you have a long journey.’ He arose, and ate and drank; then he went in the strength of that food forty days and forty nights to mount Horeb. There he entered a cave, and spent the night there. Then the word of the Lord came to him, saying, ‘Go forth, and stand upon the mount before the Lord. And behold, the Lord will pass by.’ And a great and strong wind rent the mountains, and broke in pieces the rocks before the Lord, but the Lord was not in the wind; and after the wind an earthquake, but the Lord was not in the earthquake; and after the earthquake a fire, but the Lord was not in the fire; and after the fire the sound of a gentle breeze. And when Elias heard it, he wrapped his face in his mantle and went out and stood by the cave. Then the Lord said to him, ‘Go, return to your way and you will come to the desert way of Damascus; and you shall anoint Elissaios son of Shaphat as prophet in your place.’');
-insert into composites values(24, 'The Lord spoke to the children of Israel saying, ‘If you walk in my ordinances and keep my commandments and do them, I will give you rain in its season and the earth will give its produce and the trees of the plains their fruit. Your threshing time will overtake the vintage, and the vintage will overtake the sowing. You will eat your bread to the full and dwell in safety on your land; and no one shall make you afraid. And I will destroy the evil wild beasts from your lands, and war shall not pass through your land, and enemies will fall before you. Five of you will pursue a hundred and a hundred of you will pursue tens of thousands. And I will look upon you and bless you and make you increase and multiply and I will establish my covenant with you. And you will eat what is old and very old, and bring out the old to make way for the new. And my soul will not abhor you, and I will walk among you, and I will be your God and you shall be my people. But if you will not listen to me, nor observe these ordinances of mine, but disobey them, and if your soul loathes my judgements, so that you do not keep all my commandments, I in turn will treat you like this: I will bring distress upon you, and you will sow your seed in vain and your enemies will devour your labours. And I will set my face against you and you will fall before your foes and they will pursue you and you will flee though no one pursues you; and I will smash the arrogance of your pride. And I will make the heaven like iron for you and your earth like solid bronze. And your strength will be in vain and your land will not give its fruit, and the trees of the field will not give their fruit. And I will send the wild beasts of the earth against you, and they will consume your cattle, and the sword will come against you and make you few in number. And your land will be desert and your farms will be desert; because you have walked against me crook­edly, and I will walk against you with crooked rage, says the Lord God, the Holy One of Israel’.');
+insert into composites values(1, 'Gen', 17, 1, 'The Lord appeared to Abram and said to him, ‘I am your God.  Be well pleasing before me and be blameless.');
+insert into composites values(1, 'Gen', 17, 2, 'I will establish my covenant between me and you, and I will multiply you greatly;');
+insert into composites values(1, 'Gen', 17, 4, 'and you will be the father of a multitude of nations.');
+insert into composites values(1, 'Gen', 17, 5, 'And your name will not be Abram, but your name will be Abraham, because I have established you as father of many nations.');
+insert into composites values(1, 'Gen', 17, 6, 'And I will increase you very greatly, and I will establish you for nations, and kings will come from you.');
+insert into composites values(1, 'Gen', 17, 7, 'And I will establish my covenant between me and you and between your seed after you for their generations as an eternal covenant,');
+insert into composites values(1, 'Gen', 17, 8, 'and I will be their God.’');
+insert into composites values(1, 'Gen', 17, 3, 'And Abraham fell on his face and worshipped the Lord.');
+insert into composites values(1, 'Gen', 17, 9, 'And God said to Abraham, ‘You are to keep my covenant, you and your seed after you to their generations.');
+insert into composites values(1, 'Gen', 17, 10, 'And this is the covenant which you are to keep between me and between your seed after you to their generations.  Every male among you shall be circumcised;');
+insert into composites values(1, 'Gen', 17, 11, 'and you shall be circumcised in the foreskin of your flesh, and it shall be for a sign of the covenant between me and you.');
+insert into composites values(1, 'Gen', 17, 12, 'And every male child among you shall be circumcised at eight days for your generations.');
+insert into composites values(1, 'Gen', 17, 14, 'And an uncircumcised male that is not circumcised in the flesh of his foreskin on the eighth day, that soul shall be wiped out from its race; because it has rejected my covenant.’');
+insert into composites values(2, 'Prov', 10, 0, 'The memory of a just man is praised, and the Lord’s blessing is upon his head. Blessed is one who has found wisdom; a mortal who knows understanding. To import her is better than treasures of gold and silver. She is more valuable than precious stones; nothing of value equals her worth. Justice proceeds from her mouth; she bears law and mercy on her tongue. Therefore, my children, listen to me, for I speak weighty things. And blessed is the one who keeps my ways. For my goings out are the goings out of life, and favour is prepared from the Lord. Therefore I exhort you, and utter my voice to the children of humankind. Because I, Wisdom, have prepared counsel, knowledge and understanding. I have called on them. Counsel and sureness are mine; prudence is mine, strength is mine. I love those who are my friends, while those who seek me will find grace. You innocent, then, understand cunning; you untaught, take it to heart. Listen to me, for I will speak weighty things, and I will open right things from my lips. Because my throat will meditate truth; lying lips are abominable before me. All the words of my mouth are with justice, there is nothing crooked in them nor twisted. They are all straight for those who understand, and right for those who find knowledge. For I teach you what is true, that your hope may be in the Lord and that you may be filled with spirit.');
+insert into composites values(3, 'Wis', 4, 0, 'A just man if he comes to his end will be at rest. A just man who dies will condemn the ungodly who are alive; for they will see the end of a just man and will not understand what they counselled concerning him. For the Lord will break the ungodly, render them voiceless and cast them headlong, and he will shake them from the foundations and they will be utterly worsted in sorrow, and their memory shall perish. They shall come with fear at the accounting of their sins, and their iniquities will convict them to their face. Then the just will stand with much boldness in the face of those who afflicted him and made his toils of no account. When they see this they will be troubled with great fear and will be amazed at the wonder of his salvation. For they will say as they repent and with anguish they will groan and say: Is this he whom we fools once made a laughing stock and a byword of reproach? We reckoned his life folly and his end dishonour. How has he been numbered among the children of God and his lot with the Saints? Therefore we have erred from the way of truth and the light of righteousness has not shone on us and the sun has not dawned on us. We have been filled with paths of lawlessness and destruction and journeyed through trackless paths, but have not known the way of the Lord.');
+insert into composites values(4, 'Prov', 10, 0, 'The mouth of a just man distils wisdom; the lips of men know graces.  The mouth of the wise meditates wisdom; justice delivers them from death.  When a just man dies hope is not lost; for a just son is born for life, and among his good things he will pluck the fruit of justice.  There is light at all times for the just, and they will find grace and glory from the Lord.  The tongue of the wise knows what is good, and wisdom will take its rest in their hearts.  The Lord loves holy hearts; while all who are blameless in the way are acceptable to him.  The wisdom of the Lord will enlighten the face of the understanding; for she anticipates those who desire her before they know it, and is easily contemplated by those who love her.  One who rises for her at dawn will not toil, and one who keeps vigil because of her will be without care.  For she goes about seeking those who are worthy of her, and shows herself favourably to those on her paths.  Wickedness will never prevail against wisdom.  Because of this I too became a lover of her beauty and became her friend, and I sought her out from my youth, and I sought to take her as my bride,  because the Master of all things loved her,  for she is an initiate of the knowledge of God and one who chooses his works.  Her toils are virtues; she herself teaches sobriety and prudence; justice and courage, than which things nothing is more useful in human life.  If anyone longs for much experience, she knows how to compare things of old and those that are to come.  She knows the twists of words and the explanations of riddles. She foresees signs and wonders and the outcomes of seasons and times.  And to all she is a good counsellor.  Because immortality is in her, and fame in the fellowship of her words.  Therefore I appealed to the Lord and besought him and said from my whole heart, ‘God of my Fathers and Lord of mercy, who made all things by your Word, and established humanity by your Wisdom to be sovereign over the creatures that had come into being by you, and to order the world in holiness and justice, give me Wisdom who sits by your throne, and do not reject me from among your children, for I am your servant and the son of your maid servant.  Send her out from your holy dwelling and from the throne of your glory, that she may be present with me and teach me what is well pleasing before you.  And she will guide me with knowledge and guard me with her glory.  For all the thoughts of mortals are wretched and their ideas are unstable.’');
+insert into composites values(5, 'Wis', 4, 0, 'When a just man is praised, peoples will be glad; for immortality is his memory, because it is known both to God and humankind, and his soul is pleasing to the Lord. Therefore, O men, desire wisdom, and long for it and be instructed. For her beginning is love and keeping of laws. Honour Wisdom, that you may reign for ever. I will declare the mysteries of God to you and not hide them from you. Because he is both the guide of Wisdom and the One who sets right the wise. In his hand are all prudence, and knowledge of works. Wisdom, the artificer of all things, taught me, for in her is an understanding spirit, holy, brightness of the eternal light and image of the goodness of God. She makes friends of God and prophets. She is more lovely than the sun, and beyond every order of the stars. If compared to light, she is found before it. She delivered her devotees from toils, and guided them in straight paths. She gave them holy knowledge and protected them from those who lay in ambush for them. She awarded them a mighty contest, that all might know that true religion is more powerful than all, and that wickedness can never prevail against Wisdom, nor will justice in passing sentence overlook evil people. For they said in themselves, not reasoning correctly, ‘Let us overpower the just one, let us not spare his saintliness, nor feel reverence for the aged grey hairs of an elder. Let our strength be law. Let us lie in ambush for the just, for he is a hindrance to us and he is opposed to our works and alleges the sins of our upbringing. He claims to have knowledge of God, and calls himself a child of the Lord. He has become a reproof to our thoughts. He is grievous for us even to look at, for his life is not like others and his paths are quite different. We are reckoned by him to be counterfeit, and he keeps away from our ways as from filth. He calls the end of just people blessed. Let us see if his words are true, and let us test what will happen to him at the end. Let us examine him by insult and torture, that we may know his forbearance and make trial of his patience. Let us condemn him to a shameful death, for he will have protection from his own words.’ This was how they argued, and they were deceived, for their wickedness had blinded them. And they did not know the mysteries of God, nor did they judge that you alone are God, who have authority over life and death, who save in time of tribulation and deliver from every ill; pitying and merciful, giving grace to your holy ones, and by your right arm resisting the proud.');
+insert into composites values(6, 'Exod', 12, 0, 'The Lord spoke to Moses on the day on which he brought the children of Israel out of the land of Egypt, saying, ‘Sanctify to me every first-born, first produced that opens every womb among the children of Israel’. And Moses said to the people, ‘Remember this day, on which you came out of Egypt, from the house of slavery, for the Lord has brought you out from there with a mighty hand. And keep his law. And it shall be that when the Lord God brings you into the land of the Chananites, in the way that he swore to your fathers, you shall set apart everything that opens the womb; the males to the Lord. But if after this your son asks you, saying, “What is this?”, you will say to him, “God brought us out of Egypt, from the house of slavery, with a mighty hand. And when Pharao hardened his heart against sending us out, the Lord slew every first-born in the land of Egypt, from the first-born of humans to the first-born of animals. This is why I sacrifice everything that opens the womb; the males to the Lord, and every first-born of my sons I will redeem”. And it will be for a sign upon your hand, and immovable before your eyes, because thus said the Lord the Almighty, “All the first-born of your sons you will give me. And it shall be that everyone who gives birth to a male child shall circumcise the flesh of its foreskin on the eighth day. And for thirty three days he will not come into the sanctuary of God to the Priest, until the days of purification are completed. And after this he will offer an unblemished yearling lamb to the Lord for a holocaust, and a young pigeon or turtle dove to the Priest at the door of the Tabernacle of Witness. Or instead of these he will offer two young pigeons or two turtle doves. And the Priest will make atonement for him. Because these have been offered to me as an offering out of all the children of Israel. And I have taken them and sanctified them for myself in place of the first-born of the Egyptians, on the day when I smote every first-born in the land of Egypt from human to beast of burden,” said God the Most High, the Holy One of Israel.’');
+insert into composites values(7, 'Isa', 19, 1, 'See, the Lord will be seated on a light cloud and will come to Egypt and the idols of Egypt will be shaken at his presence and their heart will be worsted within them.');
+insert into composites values(7, 'Isa', 19, 3, '‘And their spirit will be troubled within them, and I will frustrate their counsel');
+insert into composites values(7, 'Isa', 19, 4, 'and hand Egypt over into the hands of harsh lords,’ says the Lord Sabaoth.');
+insert into composites values(7, 'Isa', 19, 5, 'And the Egyptians will drink water that is beside the sea, while the river will fail and be dried up.');
+insert into composites values(7, 'Isa', 19, 12, 'Thus says the Lord, ‘Where now are your wise men? And let them declare to you and let them say, “What has the Lord Sabaoth planned against Egypt?”');
+insert into composites values(7, 'Isa', 19, 16, 'On that day the Egyptians will be like women, in fear and trembling in the presence of the hand of the Lord Sabaoth, which he will bring against them.');
+insert into composites values(7, 'Isa', 19, 19, 'And there will be an Altar to the Lord in the country of the Egyptians and a pillar to Lord at its border.');
+insert into composites values(7, 'Isa', 19, 20, 'And it will be for a sign for ever to the Lord in the country of Egypt, because they will cry to the Lord and he will send them a man who will save them.');
+insert into composites values(7, 'Isa', 19, 21, 'And the Lord will be known to the Egyptians. And the Egyptians will know the Lord in that day, and they will offer sacrifice and gift, and they will vow vows to the Lord and pay them.');
+insert into composites values(8, 'Isa', 40, 0, 'Thus says the Lord: Comfort, comfort my people, says God. Priests, speak to the heart of Jerusalem. Comfort her, because her humiliation has been completed; for her has sin has been abolished, because she has received from the Lord’s hand double for her sins. A voice of one crying in the wilderness: Prepare the way of the Lord, make straight the paths of our God. Every valley will be filled and every mountain and hill made low; what is crooked will become straight, and the rough ways will be made smooth; and all flesh shall see the salvation of God. Go up onto a high mountain, you who bring good tidings to Sion; lift up your voice with strength, you who bring good tidings to Jerusalem. Lift it up, do not be afraid. I the Lord God, I, the God of Israel, will hearken and will not forsake them; but I will open rivers from the mountains and springs in the middle of plains. I will turn the wilderness into water meadows and the thirsty earth with water courses. Let the heavens rejoice from on high and let the clouds rain justice. Let the earth sprout and blossom with mercy and justice. Announce a voice of gladness to the end of the earth and let this be heard: Say that the Lord has delivered his servant Jacob. And if they thirst through deserts, he will bring water for them from a rock. Rejoice you barren who have never given birth, break out and shout, you who have never known birth pangs, for the children of the deserted are more than those of her who has a husband.');
+insert into composites values(9, 'Malachi', 3, 0, 'Thus says the Lord Almighty: See, I am sending my Angel, my messenger, before your face, who will prepare your way before you. And the Lord whom you seek will come to his temple. And who will endure the day of his entrance? And who will withstand at his appearing? Because he will enter like fire in a smelting furnace and like the lye of launderers. And he will come to you in judgement; and he will be a swift witness against the wicked and against adulteresses and against those swear falsely in his name and those who do not fear him, says the Lord Almighty. Because I am the Lord your God, and I have not changed and you, children of Jacob, have perverted the laws and not kept them. Therefore turn back to me and I will turn back to you, says the Lord Almighty. And all the nations will call you blessed and you will know that I am the Lord who discern between just and lawless on the day on which I make a peculiar possession of those who love me. Know then and remember the law of Moses my servant, as I gave him commandment on Horeb, to all Israel ordinances and judgements. And see, I will send you Elias the Thesbite, before the great and manifest day of the Lord comes; he will turn again the heart of father to son and of a man to his neighbour, lest when I come I smite the earth grievously, says the Lord Almighty, God the Holy One of Israel.');
+insert into composites values(10, 'Gen', 17, 15, 'The Lord God said to Abraham: As for Sara your wife, her name shall not be called Sara, but Sarra shall be her name.');
+insert into composites values(10, 'Gen', 17, 16, 'I will bless her and will give you a child from her; and I will bless it and it shall be for nations and kings of nations will come from it.');
+insert into composites values(10, 'Gen', 17, 17, 'And Abraham fell on his face, and laughed and said in his mind; Shall a son be born in my hundredth year? And shall Sarra who is ninety bear a child?');
+insert into composites values(10, 'Gen', 17, 19, 'God said to Abraham: Yes; see, your wife Sarra will bear you a son and you will call his name Isaac; and I will establish my covenant with him as an everlasting covenant.');
+insert into composites values(10, 'Gen', 18, 11, 'Now Abraham and Sarra were old, advanced in years.');
+insert into composites values(10, 'Gen', 18, 12, 'But Sarra laughed to herself, saying: The thing has not happened to me until now; and my lord is old.');
+insert into composites values(10, 'Gen', 18, 13, 'And the Lord God said to Abraham: Why did Sarra laugh to herself, saying: Shall I really give birth? For I am aged.');
+insert into composites values(10, 'Gen', 18, 14, 'But nothing is impossible for God.');
+insert into composites values(10, 'Gen', 21, 1, 'And the Lord visited Sarra, as He had said,');
+insert into composites values(10, 'Gen', 21, 2, 'and she conceived and bore a son to Abraham in their old age at the time the Lord had said to him.');
+insert into composites values(10, 'Gen', 21, 4, 'But he circumcised him on the eighth day, as the Lord God had commanded him.');
+insert into composites values(10, 'Gen', 21, 5, 'And Abraham was a hundred when Isaac his son was born to him.');
+insert into composites values(10, 'Gen', 21, 6, 'But Sarra said: The Lord has given me laughter; for whoever hears will rejoice with me.');
+insert into composites values(10, 'Gen', 21, 7, 'And she said: Who will announce to Abraham that Sarra is suckling a child, for I have born a child in my old age?');
+insert into composites values(10, 'Gen', 21, 8, 'And the child grew and was weaned. And Abraham held a great banquet on the day his son Isaac was weaned.');
+insert into composites values(11, 'Judges', 13, 2, 'In those days there was a man of the tribe of Dan and his name was Manoe, and his wife was barren and had not borne a child.');
+insert into composites values(11, 'Judges', 13, 3, 'And the Angel of the Lord appeared to his wife and said to her: See, you are barren and have not borne a child or conceived a son.');
+insert into composites values(11, 'Judges', 13, 4, 'But now take care, and do not drink wine or strong drink, and eat nothing unclean.');
+insert into composites values(11, 'Judges', 13, 5, 'Because see, you will conceive in the womb and bear a son, and no iron shall touch his head, because the child is to be a Nazarite to God from his mother’s womb.');
+insert into composites values(11, 'Judges', 13, 6, 'And the woman came and spoke to her husband, saying: A Man of God came to me, and his appearance was as the appearance on an Angel of God, exceedingly bright.');
+insert into composites values(11, 'Judges', 13, 7, 'And he said: See, you will conceive in the womb and bear a son; and now take care, and drink no wine or strong drink, and eat nothing unclean, for the child shall be a Nazarite of God from the womb to the day of his death.');
+insert into composites values(11, 'Judges', 13, 8, 'And Manoe besought the Lord and said: My Lord, let the man of God, whom You sent, come again to us, and enlighten us what we should do for the child that is to be born.');
+insert into composites values(11, 'Judges', 13, 13, 'Then the Angel came to Manoe and said: Of all the things that I said to your wife, let her take care.');
+insert into composites values(11, 'Judges', 13, 14, 'She is not to eat anything that comes from the vine; she is not to drink wine or strong drink.');
+insert into composites values(11, 'Judges', 13, 17, 'And Manoe said to the Angel of the Lord: What is your name? That when your word comes to pass, we may glorify you.');
+insert into composites values(11, 'Judges', 13, 18, 'And the Angel of the Lord said to him: Why do you ask my name? For it is wonderful.');
+insert into composites values(11, 'Judges', 13, 21, 'And the Angel of the Lord appeared no more to Manoe and his wife.');
+insert into composites values(12, '3 Kgs', 17, 1, 'The word of the Lord came to the Prophet Elias and he said to Achab, ‘As the Lord the God of powers lives, the God of Israel, before whom I stand today, there shall be neither dew nor rain during these years, except by my mouth.’');
+insert into composites values(12, '3 Kgs', 17, 2, 'The word of the Lord came to Elias, saying,');
+insert into composites values(12, '3 Kgs', 17, 3, '‘Go from here and towards the east, and hide yourself in the brook Chorrath, which is opposite the Jordan.');
+insert into composites values(12, '3 Kgs', 17, 4, 'You shall drink from the brook, and I am commanding the ravens to feed you there.’');
+insert into composites values(12, '3 Kgs', 17, 5, 'So he went and settled by the brook Chorrath, which is opposite the Jordan.');
+insert into composites values(12, '3 Kgs', 17, 6, 'The ravens brought him bread in the morning, and meat in the evening; and he drank water from the brook.');
+insert into composites values(12, '3 Kgs', 17, 7, 'And it cane to pass after some days that the brook dried up, because there was no rain on the land.');
+insert into composites values(12, '3 Kgs', 17, 8, 'Then the word of the Lord came to Elias, saying,');
+insert into composites values(12, '3 Kgs', 17, 9, '‘Arise and go to Sarepta, which belongs to Sidon, and settle there; for see, I am commanding a widow there to feed you.’');
+insert into composites values(12, '3 Kgs', 17, 10, 'And he arose and went to Sarepta, to the gate of the city. And a widow was there gathering sticks. And Elias he called after her and said, ‘Bring me a little water in a vessel, so that I may drink.’');
+insert into composites values(12, '3 Kgs', 17, 11, 'As she was going to bring it, he called after her and said, ‘Bring me a morsel of bread in your hand.’');
+insert into composites values(12, '3 Kgs', 17, 12, 'But the woman said, ‘As the Lord your God lives, I have nothing baked, only a handful of flour in the jar, and a little oil in the jug; I am now gathering a couple of sticks, so that I may go home and prepare it for myself and my children, that we may eat it, and die.’');
+insert into composites values(12, '3 Kgs', 17, 13, 'Elias said to her, ‘Take courage. Go and do as you have said; but first make me a little cake of it and bring it to me, and afterwards make something for yourself and your children.');
+insert into composites values(12, '3 Kgs', 17, 14, 'For thus says the Lord the God of Israel: The jar of flour will not fail and the jug of oil will not grow less until the day that the Lord sends rain on the whole land.’');
+insert into composites values(12, '3 Kgs', 17, 15, 'The woman went and did as Elias said, and he and she and her children ate.');
+insert into composites values(12, '3 Kgs', 17, 16, 'And from that day the jar of flour did not fail, neither did the jug of oil grow less, according to the word of the Lord that he spoke by Elias.');
+insert into composites values(12, '3 Kgs', 17, 17, 'After this the son of the woman, the mistress of the house, became ill; his illness was so severe that there was no breath left in him.');
+insert into composites values(12, '3 Kgs', 17, 18, 'She then said to Elias, ‘Why do you trouble me, man of God? Have you come to me to bring my sins to remembrance, and to cause the death of my son?’');
+insert into composites values(12, '3 Kgs', 17, 19, 'But he said to her, ‘Give me your son.’ He took him from her bosom, carried him up into the upper chamber where he was lodging, and laid him on his own bed.');
+insert into composites values(12, '3 Kgs', 17, 20, 'He cried out to the Lord, ‘Alas, Lord my God, you have brought calamity upon the widow with whom I am staying, whose witness you are, by killing her son.’');
+insert into composites values(12, '3 Kgs', 17, 21, 'Then he breathed upon the child three times, and called on the Lord and said, ‘Lord my God, let this child’s life come into him again.’ And so it happened and he cried out.');
+insert into composites values(12, '3 Kgs', 17, 22, 'And the Lord listened to the voice of Elias; the child’s soul came into him again, and he lived.');
+insert into composites values(12, '3 Kgs', 17, 23, 'Elias took the child, brought him down from the upper chamber into the house, and gave him to his mother. Then Elias said, ‘See, your son is alive.’');
+insert into composites values(12, '3 Kgs', 17, 24, 'So the woman said to Elias, ‘Now I know that you are a man of God, and that the word of the Lord in your mouth is true.’');
+insert into composites values(13, '3 Kgs', 18, 0, 'The word of the Lord came to Elias the Thesbite in the third year, saying, ‘Go, and appear before Achab, and I will give rain on the face of the land. And it came to pass that when Achab saw Elias, he said to him, ‘Is it you, the one who is troubling Israel?’ He answered, ‘I am not troubling Israel; but you are, and your father’s house, by forsaking the Lord our God and following Baal. Now therefore have all Israel assemble to me at Mount Carmel, with the four hundred fifty prophets of Baal and the four hundred prophets of the scared groves, who eat at Jezebel’s table.’ So Achab sent to all Israel, and assembled the prophets at Mount Carmel. Elias said to them, ‘How long will you go limping with two different opinions? If the Lord is God, follow him; but if Baal, then follow him.’ Then Elias said to the people, ‘I, even I only, am left a prophet of the Lord; but the prophets of the sacred grove are very many. Let two bulls be given to us; let them choose one bull for themselves, cut it in pieces, and lay it on the wood, but put no fire to it; I will prepare the other bull, but put no fire to it. Then you call on the name of your god and I will call on the name of the Lord my God. And the god who answers by fire shall be God.’ All the people answered, ‘The word you have spoken today is good.’ Then Elias said to the prophets of shame, ‘Choose for yourselves one calf and prepare it first; then call on the name of your god, but put no fire to it.’ So they took the calf, prepared it, and called on the name of Baal from morning until noon, crying, ‘O Baal, hear us!’ But there was no voice, and no answer. They ran upon the altar that they had made. At noon Elias the Thesbite mocked them, saying, ‘Cry aloud! For your god likes garrulousness.’ And when the time of the offering of the oblation came, there was nothing. Then Elias the Thesbite said to the prophets of abominations, ‘Stand aside now, and I will offer my holocaust’. And Elias said to the people, ‘Come close’. And all the people came closer to him. Elias took twelve stones, according to the number of the tribes of Israel, to whom the word of the Lord had come, saying, ‘Israel shall be your name’. With the stones he built and repaired the altar of the Lord that had been cast down. Then he made a trench around the altar, large enough to contain two measures of seed. Next he put the pieces of wood on the altar he had made, cut the holocaust in pieces, and laid them on the pieces of wood and piled them on the altar. He said, ‘Bring me two jars of water and pour it on the holocaust and on the pieces of wood.’ Then he said, ‘Do it a second time’; and they did it a second time. Again he said, ‘Do it a third time’; and they did it a third time, so that the water ran all around the altar, and filled the trench also with water. And the prophet Elias cried aloud to heaven and said, ‘Lord, God of Abraham, Isaac, and Israel, hear me today by fire. And let this people known that you alone the Lord. the God of Israel, that I am your servant, and that through you I have done all these things, and that you have turned back the heart of this people to you.’ Then fire from the Lord fell from heaven and consumed the holocaust and the pieces of wood; and the fire licked up the water that was in the trench, the stones, and the dust. And the people fell on their faces and said, ‘The Lord indeed is God; he is God.’ Elias said to them, ‘Seize the prophets of Baal; do not let one of them escape.’ Then they seized them; and Elias brought them down to the brook Kishon, and killed them there. And after this Elias said to Achab, ‘There is a sound of rushing rain. Harness your chariot and go down, lest the rain catch you.’ Then Elias went up to the top of Carmel; there he bowed himself down upon the earth and put his face between his knees and prayed to the Lord. And the heavens grew black with clouds and wind; there was a heavy rain. Achab went to Jezreel. Achab told Jezebel his wife all that Elias had done. Then Jezebel sent to Elias, saying, ‘Tomorrow I will sacrifice your life like one of them.’ And Elias heard and was afraid; he arose and fled for his life, and came to Beersheba, in the land of Juda; he left his servant there. But he himself went a day’s journey into the wilderness, and came and sat down under a solitary broom tree. Then he lay down under the broom tree and fell asleep. Suddenly someone touched him and said to him, ‘Arise and eat and drink, for you have a long journey.’ Elias looked, and there at his head was a cake of flour and a jar of water. He arose, ate and drank, and slept again. The angel of the Lord came a second time, touched him, and said, ‘Arise and eat and drink, for you have a long journey.’ He arose, and ate and drank; then he went in the strength of that food forty days and forty nights to mount Horeb. There he entered a cave, and spent the night there. Then the word of the Lord came to him, saying, ‘What are you doing here, Elias?’ Elias answered, ‘I have been very zealous for the Lord, the Almighty; for the children of Israel have forsaken your covenant, thrown down your altars, and killed your prophets with the sword. I alone am left, and they are seeking my life, to take it away.’ Then the Lord said to him, ‘Go, return to your way and you will come to the desert way of Damascus; and you shall anoint Elissaios son of Shaphat as prophet in your place.');
+insert into composites values(14, '3 Kgs', 19, 19, 'A day came and Elias found Elissaios son of Saphat, who was ploughing. Elias passed by him and threw his mantle over him.');
+insert into composites values(14, '3 Kgs', 19, 20, 'Elissaios left the oxen, ran after Elias,');
+insert into composites values(14, '3 Kgs', 19, 21, 'and ministered to him.');
+insert into composites values(14, '4 Kgs', 2, 1, 'And it came to pass, when the Lord took Elias in a whirlwind as though up to heaven, that Elias went with Elissaios to Galgala.');
+insert into composites values(14, '4 Kgs', 2, 6, 'Then Elias said to Elissaios, ‘Stay here; for the Lord has sent me as far as the Jordan.’ But he said, ‘As the Lord lives, and as you yourself live, I will not leave you.’ So the two of them went on.');
+insert into composites values(14, '4 Kgs', 2, 7, 'Fifty men of the sons of the prophets came, and stood at some distance from them, as they both were standing by the Jordan.');
+insert into composites values(14, '4 Kgs', 2, 8, 'Then Elias took his mantle and rolled it up, and struck the water with it; the water was parted to the one side and to the other, and the two of them crossed on dry ground.');
+insert into composites values(14, '4 Kgs', 2, 9, 'When they had crossed, Elias said to Elissaios, ‘Ask me what I may do for you, before I am taken up from you.’ Elissaios said, ‘Please let me inherit a double share of your spirit.’');
+insert into composites values(14, '4 Kgs', 2, 10, 'He responded, ‘You have asked a hard thing; yet, if you see me as I am being taken up from you, it will be granted you; if not, it will not.’');
+insert into composites values(14, '4 Kgs', 2, 11, 'It came to pass that as they continued walking and talking, a chariot of fire and horses of fire separated the two of them, and Elias was taken up in a whirlwind as if into heaven.');
+insert into composites values(14, '4 Kgs', 2, 12, 'Elissaios kept watching and crying out, ‘Father, father! The chariots of Israel and its horsemen!’ But when he could no longer see him, Elissaios grasped his own clothes and tore them in two pieces.');
+insert into composites values(14, '4 Kgs', 2, 13, 'He picked up the mantle of Elias that had fallen from him, and went back and stood on the bank of the Jordan.');
+insert into composites values(14, '4 Kgs', 2, 14, 'Elissaios took the mantle of Elias that had fallen from him, and struck the water, saying, ‘Where then is the God of Elias, Appho?’ And so  he struck the water, and the water was parted to the one side and to the other, and Elissaios went over on dry ground.');
+insert into composites values(15, 'Exod', 33, 11, 'The Lord spoke to Moses face to face, as one speaks to one’s friend. Then he would return to the camp; but the young servant, Jesus, son of Navi, did not leave the tent.');
+insert into composites values(15, 'Exod', 33, 12, 'Moses said to the Lord, ‘See, you say to me, “Bring up this people”; but you have not shown me whom you will send with me. Yet you have said to me, “I know you above all others, and you have also found favour in my sight.”');
+insert into composites values(15, 'Exod', 33, 13, 'Now if I have found favour in your sight, show yourself to me, so that I may see you and find favour in your sight, that I may know that this great nation is your people.‘');
+insert into composites values(15, 'Exod', 33, 14, 'And the Lord said to him, ‘I myself will go before you, and I will give you rest.’');
+insert into composites values(15, 'Exod', 33, 15, 'And he said to him, ‘If you will not go with us yourself, do not carry me up from here.');
+insert into composites values(15, 'Exod', 33, 16, 'For how shall it be truly known that I have found favour in your sight, I and your people, unless you go with us? In this way, we shall be glorified, I and your people, more than all the nations.’');
+insert into composites values(15, 'Exod', 33, 17, 'The Lord said to Moses, ‘For you I will do this word that you have spoken; for you have found favour in my sight, and I know you above all others.’');
+insert into composites values(15, 'Exod', 33, 18, 'Moses said, ‘Show me your own glory.’');
+insert into composites values(15, 'Exod', 33, 19, 'And he said, ‘I will pass by you in my glory, and will proclaim before you my name, “The Lord”; and I will be have mercy on those on whom I will have mercy, and will have pity on those on whom I will have pity.’');
+insert into composites values(15, 'Exod', 33, 20, 'And he said, ‘You cannot see my face; for no human shall see my face and live.’');
+insert into composites values(15, 'Exod', 33, 21, 'And the Lord said, ‘See, there is a place by me; stand on the rock.');
+insert into composites values(15, 'Exod', 33, 22, 'And while my glory passes by I will put you in a cleft of the rock, and I will cover you with my hand until I have passed by;');
+insert into composites values(15, 'Exod', 33, 23, 'then I will take away my hand, and you shall see my back; but my face shall not be seen by you.’');
+insert into composites values(15, 'Exod', 34, 4, 'So Moses rose early in the morning and went up on Mount Sina, as the Lord had commanded him.');
+insert into composites values(15, 'Exod', 34, 5, 'The Lord descended in the cloud and stood with him there, and proclaimed the name, ‘The Lord.');
+insert into composites values(15, 'Exod', 34, 6, 'The Lord passed before his face, and proclaimed, ‘The Lord, the Lord, God compassionate and merciful, slow to anger, and full of mercy and true’.');
+insert into composites values(15, 'Exod', 34, 8, 'And Moses quickly bowed to the earth, and worshipped the Lord.');
+insert into composites values(16, 'Isa', 63, 15, 'Look down from heaven, Lord, and see from your holy house and your glory. Where is the multitude of your mercy and your pity, that you keep back from us, O Lord?');
+insert into composites values(16, 'Isa', 63, 16, 'For you are our Father, though Abraham did not know us, though Israel did not ackno­wledge us; but you are our Father, deliver us. From the beginning your name is upon us.');
+insert into composites values(16, 'Isa', 63, 17, 'Why have you made us wander from your way, Lord? Why have you hardened our hearts not to fear you? Turn back for the sake of your servants, for the tribes of your inheritance,');
+insert into composites values(16, 'Isa', 63, 18, 'that we may inherit a small part of your holy mountain. Our enemies have trampled down your sanctuary.');
+insert into composites values(16, 'Isa', 63, 19, 'We have become as at the beginning, when you did not know us, when your name had not been invoked upon us.');
+insert into composites values(16, 'Isa', 64, 1, 'If you open the heaven, trembling will take hold upon mountains from you, and they will melt as wax melts before the fire;');
+insert into composites values(16, 'Isa', 64, 2, 'and fire will burn up your enemies, and your name will be manifest to your enemies; before your face nations will be troubled.');
+insert into composites values(16, 'Isa', 64, 3, 'When you do glorious things trembling from you will seize mountains.');
+insert into composites values(16, 'Isa', 64, 4, 'From eternity we have not heard, nor have we seen a God save you; and your works are true, and you do mercy to those who wait on you.');
+insert into composites values(16, 'Isa', 64, 5, 'For mercy will meet those who act justly, and they will be mind­ful of your ways.');
+insert into composites values(16, 'Isa', 64, 8, 'And now, Lord, your are our Father, while we are your clay and you are our Fashioner; we are all the works of your hands;');
+insert into composites values(16, 'Isa', 64, 9, 'do not be very angry with us, Lord, and do not remember our sins in season. And now look upon, Lord, for we are all your people.');
+insert into composites values(19, 'Micah', 4, 2, 'Thus says the Lord: From Sion will come forth the Law and the Word of the Lord from Jerusalem.');
+insert into composites values(19, 'Micah', 4, 3, 'And he will judge among many peoples and rebuke mighty nations in a distant land.');
+insert into composites values(19, 'Micah', 4, 5, 'For all the peoples will walk, each its own way, while we will walk in the name of the Lord our God for ever.');
+insert into composites values(19, 'Micah', 6, 2, 'Thus says the Lord Almighty: Listen hills and valleys, foundations of the earth, because the Lord has a controversy with his people; for he will dispute with Israel, saying,');
+insert into composites values(19, 'Micah', 6, 3, '‘My people, what have I done to you? Or how have I grieved you? Or how have I troubled you? Answer me.');
+insert into composites values(19, 'Micah', 6, 4, 'For I brought you up out of the land of Egypt, and rescued you from the house of slavery, and sent Moses and Aaron before your face.');
+insert into composites values(19, 'Micah', 6, 5, 'My people, what have your enemies planned against you?');
+insert into composites values(19, 'Micah', 6, 8, 'Was it not told you, O man, what is good? And what does the Lord seek from you, except to execute judgement, and to love mercy and to be ready to walk with the Lord your God?’');
+insert into composites values(19, 'Micah', 5, 4, 'Therefore the Lord will be magnified in strength, and will shepherd his flock');
+insert into composites values(19, 'Micah', 5, 5, 'in peace, to the extremities of the earth.');
+insert into composites values(20, 'Isa', 55, 1, 'Thus says the Lord: You who thirst, go to the water; and all who have no money, go, buy and eat and drink wine and fat without money or price.');
+insert into composites values(20, 'Isa', 12, 3, 'For thus says the Lord Almighty to you: My people, draw water with joy from the springs of salvation.');
+insert into composites values(20, 'Isa', 12, 4, 'And you will say in that day: Praise the Lord, cry his name aloud, declare his glory among the nations, call to mind that his name has been exalted.');
+insert into composites values(20, 'Isa', 55, 2, 'My people, hear me, and eat good things, and your soul will delight in good things.');
+insert into composites values(20, 'Isa', 55, 3, 'Attend with your ears and follow my ways. Listen to me and your soul will live among good things. And I will make an eternal covenant with you,');
+insert into composites values(20, 'Isa', 55, 6, 'and you will call upon me. And when you draw near me,');
+insert into composites values(20, 'Isa', 55, 7, 'let the impious abandon his ways, and the lawless man his plans; and turn back to me and I will have mercy on you and forgive your sins.');
+insert into composites values(20, 'Isa', 55, 8, 'For your plans are not as my plans, says the Lord;');
+insert into composites values(20, 'Isa', 55, 9, 'but as heaven is distant from the earth, so is my way distant from your ways, and your thoughts from my mind.');
+insert into composites values(20, 'Isa', 55, 10, 'For as rain or snow would come down from heaven and not return there, until it had soaked the earth, and it bring forth and bud and give seed to the sower, and bread for food,');
+insert into composites values(20, 'Isa', 55, 11, 'so shall my word be, which once it has come from my mouth will not return there until it has accomplished all that I willed; and I will make my ways and my commands succeed.');
+insert into composites values(20, 'Isa', 55, 12, 'For you will go out with joy, and be taught with gladness; for the mountains and hills will exult as they receive you with joy; and all the trees of the field will clap with their branches;');
+insert into composites values(20, 'Isa', 55, 13, 'and instead of the briar shall come up the cypress; instead of the nettle shall come up the myrtle; and the Lord shall be for a name and for an eternal sign, says the Lord God, the Holy One of Israel.');
+insert into composites values(21, 'Isa', 62, 10, 'Thus says the Lord: Walk, go through my gates; prepare my way and make a way for my people, and cast the stones out of the way; raise up a standard for the nations.');
+insert into composites values(21, 'Isa', 62, 11, 'For see, the Lord has made it heard to the ends of the earth: Say to the daughter of Sion: See, your Saviour has come, and his reward is with him, and his work before his face.');
+insert into composites values(21, 'Isa', 62, 12, 'And he will call it a holy people, redeemed by the Lord; while you will be called a city sought after, and not forsaken.');
+insert into composites values(21, 'Isa', 63, 1, 'Who is this who comes from Edom, the scarlet of his garments from Bosor, thus beautiful in his apparel? He cries out with much strength. I reason of justice and judgement of salvation.');
+insert into composites values(21, 'Isa', 63, 2, 'Why are your garments red, and your clothing as from a trodden winepress?');
+insert into composites values(21, 'Isa', 63, 3, 'I am full of the trodden grape; I have trampled the winepress quite alone, and no man from the nations was with me.');
+insert into composites values(21, 'Isa', 63, 7, 'I have remembered the mercy of the Lord, I will recall the Lord’s virtues, the Lord’s praise for all the things with which He rewards us. The Lord is a good judge for the house of Israel; he deals with us according to his mercy and according to the multitude of his justice.');
+insert into composites values(21, 'Isa', 63, 8, 'And he said: Are you not my people? Children will surely not be rebellious; and he became for them salvation');
+insert into composites values(21, 'Isa', 63, 9, 'out of their every distress. It was not an emissary, not an Angel, but the Lord himself saved them because he loved them and spared them. He redeemed them and took them up and exalted them all the days of the age.');
+insert into composites values(22, 'Zech', 14, 1, 'Thus says the Lord: See, the day of the Lord is coming,');
+insert into composites values(22, 'Zech', 14, 4, 'and on that day his feet will stand upon the mount of Olives, opposite Jerusalem, where the sun rises.');
+insert into composites values(22, 'Zech', 14, 8, 'And on that day living water will come out from Jerusalem, half towards the first sea and half towards the last sea; in spring and in summer it shall be so;');
+insert into composites values(22, 'Zech', 14, 9, 'and the Lord will be for a King over all the earth; in that day there shall be one Lord, and his name,');
+insert into composites values(22, 'Zech', 14, 10, 'compassing all the earth and the wilderness from Gabaa as far as Remmon, south of Jerusalem; and he shall be exalted and remain on his place from the gate of Benjamin as far as the place of the first gate, as far as the gate of Gomor and as far as the tower of Anameël and as far as the tower of the corners and as far as the king’s winepresses;');
+insert into composites values(22, 'Zech', 14, 11, 'they shall dwell in it and there shall be no more curse and Jerusalem shall dwell confidently.');
+insert into composites values(23, '3 Kgs', 19, 3, 'And Elias heard and was afraid; he arose and fled for his life, and came to Beersheba, in the land of Juda; he left his servant there.');
+insert into composites values(23, '3 Kgs', 19, 4, 'But he himself went a day’s journey into the wilderness, and came and sat down under a solitary broom tree.');
+insert into composites values(23, '3 Kgs', 19, 5, 'Then he lay down under the broom tree and fell asleep. Suddenly someone touched him and said to him, ‘Arise and eat and drink, for you have a long journey.’');
+insert into composites values(23, '3 Kgs', 19, 6, 'Elias looked, and there at his head was a cake of flour and a jar of water. He arose, ate and drank, and slept again.');
+insert into composites values(23, '3 Kgs', 19, 7, 'The angel of the Lord came a second time, touched him, and said, ‘Arise and eat and drink, for you have a long journey.’');
+insert into composites values(23, '3 Kgs', 19, 8, 'He arose, and ate and drank; then he went in the strength of that food forty days and forty nights to mount Horeb.');
+insert into composites values(23, '3 Kgs', 19, 9, 'There he entered a cave, and spent the night there.');
+insert into composites values(23, '3 Kgs', 19, 11, 'Then the word of the Lord came to him, saying, ‘Go forth, and stand upon the mount before the Lord. And behold, the Lord will pass by.’ And a great and strong wind rent the mountains, and broke in pieces the rocks before the Lord, but the Lord was not in the wind; and after the wind an earthquake, but the Lord was not in the earthquake;');
+insert into composites values(23, '3 Kgs', 19, 12, 'and after the earthquake a fire, but the Lord was not in the fire; and after the fire the sound of a gentle breeze.');
+insert into composites values(23, '3 Kgs', 19, 13, 'And when Elias heard it, he wrapped his face in his mantle and went out and stood by the cave.');
+insert into composites values(23, '3 Kgs', 19, 15, 'Then the Lord said to him, ‘Go, return to your way and you will come to the desert way of Damascus;');
+insert into composites values(23, '3 Kgs', 19, 16, 'and you shall anoint Elissaios son of Shaphat as prophet in your place.’');
+insert into composites values(24, 'Lev', 26, 0, 'The Lord spoke to the children of Israel saying, ‘If you walk in my ordinances and keep my commandments and do them, I will give you rain in its season and the earth will give its produce and the trees of the plains their fruit. Your threshing time will overtake the vintage, and the vintage will overtake the sowing. You will eat your bread to the full and dwell in safety on your land; and no one shall make you afraid. And I will destroy the evil wild beasts from your lands, and war shall not pass through your land, and enemies will fall before you. Five of you will pursue a hundred and a hundred of you will pursue tens of thousands. And I will look upon you and bless you and make you increase and multiply and I will establish my covenant with you. And you will eat what is old and very old, and bring out the old to make way for the new. And my soul will not abhor you, and I will walk among you, and I will be your God and you shall be my people. But if you will not listen to me, nor observe these ordinances of mine, but disobey them, and if your soul loathes my judgements, so that you do not keep all my commandments, I in turn will treat you like this: I will bring distress upon you, and you will sow your seed in vain and your enemies will devour your labours. And I will set my face against you and you will fall before your foes and they will pursue you and you will flee though no one pursues you; and I will smash the arrogance of your pride. And I will make the heaven like iron for you and your earth like solid bronze. And your strength will be in vain and your land will not give its fruit, and the trees of the field will not give their fruit. And I will send the wild beasts of the earth against you, and they will consume your cattle, and the sword will come against you and make you few in number. And your land will be desert and your farms will be desert; because you have walked against me crook­edly, and I will walk against you with crooked rage, says the Lord God, the Holy One of Israel’.');
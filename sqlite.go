@@ -0,0 +1,346 @@
+package orthocal
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// The queries used by SQLiteStore. Sets of values are passed as a single JSON
+// array parameter so that every query has a fixed number of parameters and
+// can be prepared once.
+const (
+	commemorationsQuery = `
+		select pdist, month, day, title, subtitle, feast_name, feast_level, service_note, saint, fast, fast_exception
+		from days
+		where rowid in (
+			select rowid from days where pdist in (select value from json_each(?1))
+			union
+			select d.rowid from json_each(?2) j join days d on d.month = j.value / 100 and d.day = j.value % 100
+		)
+		order by rowid`
+
+	readingsQuery = `
+		select pdist, month, day, source, desc, book, pericope, ordering
+		from readings
+		where rowid in (
+			select rowid from readings where pdist in (select value from json_each(?1))
+			union
+			select r.rowid from json_each(?2) j join readings r on r.month = j.value / 100 and r.day = j.value % 100
+		)
+		order by rowid`
+
+	pericopesQuery = `
+		select p.book, p.pericope, display, sdisplay, desc, preverse, prefix, prefixb, verses, suffix
+		from json_each(?1) j join pericopes p
+		on p.book = json_extract(j.value, '$[0]') and p.pericope = json_extract(j.value, '$[1]')
+		order by p.rowid`
+
+	compositeQuery = `
+		select book, chapter, verse, reading
+		from composites
+		where composite_num = ?1
+		order by rowid`
+
+	// Databases built before the composites were divided into verses have
+	// the whole text of a composite in one row.
+	legacyCompositeQuery = `
+		select '', 0, 0, reading
+		from composites
+		where composite_num = ?1
+		order by rowid`
+
+	compositeReferencesQuery = `
+		select book, start_chapter, start_verse, end_chapter, end_verse
+		from composite_references
+		where composite_num = ?1
+		order by rowid`
+)
+
+// SQLiteStore is a CalendarStore backed by a SQLite database created with
+// createdb.sh. The SQLite library must include the JSON functions.
+//
+// A database built by an older createdb.sh still works, but its composites
+// are not divided into verses and have no references. Run createdb.sh again
+// to rebuild it.
+type SQLiteStore struct {
+	db *sql.DB
+
+	mutex               sync.Mutex
+	prepared            bool
+	commemorations      *sql.Stmt
+	readings            *sql.Stmt
+	pericopes           *sql.Stmt
+	compositesPrepared  bool
+	composite           *sql.Stmt
+	compositeReferences *sql.Stmt // nil if the database has no references
+}
+
+func NewSQLiteStore(db *sql.DB) *SQLiteStore {
+	return &SQLiteStore{db: db}
+}
+
+// Prepare the statements the first time they are needed.
+func (self *SQLiteStore) prepare(ctx context.Context) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.prepared {
+		return nil
+	}
+
+	statements := []struct {
+		stmt  **sql.Stmt
+		query string
+	}{
+		{&self.commemorations, commemorationsQuery},
+		{&self.readings, readingsQuery},
+		{&self.pericopes, pericopesQuery},
+	}
+
+	for _, s := range statements {
+		stmt, e := self.db.PrepareContext(ctx, s.query)
+		if e != nil {
+			for _, s := range statements {
+				if *s.stmt != nil {
+					(*s.stmt).Close()
+					*s.stmt = nil
+				}
+			}
+			return queryError(ctx, e)
+		}
+		*s.stmt = stmt
+	}
+
+	self.prepared = true
+	return nil
+}
+
+// Prepare the composite statements the first time they are needed. They are
+// prepared separately so that the schema of an older database only matters
+// to composites.
+func (self *SQLiteStore) prepareComposites(ctx context.Context) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.compositesPrepared {
+		return nil
+	}
+
+	stmt, e := self.db.PrepareContext(ctx, compositeQuery)
+	if e != nil && ctx.Err() == nil {
+		stmt, e = self.db.PrepareContext(ctx, legacyCompositeQuery)
+	}
+	if e != nil {
+		return queryError(ctx, e)
+	}
+	self.composite = stmt
+
+	stmt, e = self.db.PrepareContext(ctx, compositeReferencesQuery)
+	if e != nil && ctx.Err() != nil {
+		self.composite.Close()
+		self.composite = nil
+		return ctx.Err()
+	}
+	self.compositeReferences = stmt
+
+	self.compositesPrepared = true
+	return nil
+}
+
+func (self *SQLiteStore) closeStatements() {
+	for _, stmt := range []**sql.Stmt{&self.commemorations, &self.readings, &self.pericopes, &self.composite, &self.compositeReferences} {
+		if *stmt != nil {
+			(*stmt).Close()
+			*stmt = nil
+		}
+	}
+}
+
+// Close releases the prepared statements. It does not close the database.
+func (self *SQLiteStore) Close() error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.closeStatements()
+	self.prepared = false
+	self.compositesPrepared = false
+
+	return nil
+}
+
+func (self *SQLiteStore) Commemorations(ctx context.Context, pdists []int, dates []MonthDay) ([]CommemorationRecord, error) {
+	var records []CommemorationRecord
+
+	if e := self.prepare(ctx); e != nil {
+		return nil, e
+	}
+
+	rows, e := self.commemorations.QueryContext(ctx, jsonArray(pdists), jsonArray(dateKeys(dates)))
+	if e != nil {
+		return nil, queryError(ctx, e)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r CommemorationRecord
+		e := rows.Scan(&r.PDist, &r.Month, &r.Day, &r.Title, &r.Subtitle, &r.FeastName, &r.FeastLevel, &r.ServiceNote, &r.Saint, &r.Fast, &r.FastException)
+		if e != nil {
+			return nil, fmt.Errorf("%w: %w", ErrScan, e)
+		}
+		records = append(records, r)
+	}
+	if e := rows.Err(); e != nil {
+		return nil, queryError(ctx, e)
+	}
+
+	return records, nil
+}
+
+func (self *SQLiteStore) Readings(ctx context.Context, pdists []int, dates []MonthDay) ([]ReadingRecord, error) {
+	var records []ReadingRecord
+
+	if e := self.prepare(ctx); e != nil {
+		return nil, e
+	}
+
+	rows, e := self.readings.QueryContext(ctx, jsonArray(pdists), jsonArray(dateKeys(dates)))
+	if e != nil {
+		return nil, queryError(ctx, e)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r ReadingRecord
+		e := rows.Scan(&r.PDist, &r.Month, &r.Day, &r.Source, &r.Description, &r.Book, &r.Pericope, &r.Ordering)
+		if e != nil {
+			return nil, fmt.Errorf("%w: %w", ErrScan, e)
+		}
+		records = append(records, r)
+	}
+	if e := rows.Err(); e != nil {
+		return nil, queryError(ctx, e)
+	}
+
+	return records, nil
+}
+
+func (self *SQLiteStore) Pericopes(ctx context.Context, keys []PericopeKey) ([]PericopeRecord, error) {
+	var records []PericopeRecord
+
+	if len(keys) == 0 {
+		return records, nil
+	}
+
+	if e := self.prepare(ctx); e != nil {
+		return nil, e
+	}
+
+	var pairs [][2]string
+	for _, key := range keys {
+		pairs = append(pairs, [2]string{key.Book, key.Pericope})
+	}
+
+	rows, e := self.pericopes.QueryContext(ctx, jsonArray(pairs))
+	if e != nil {
+		return nil, queryError(ctx, e)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r PericopeRecord
+		e := rows.Scan(&r.Book, &r.Pericope, &r.Display, &r.ShortDisplay, &r.Description, &r.Preverse, &r.Prefix, &r.PrefixB, &r.Verses, &r.Suffix)
+		if e != nil {
+			return nil, fmt.Errorf("%w: %w", ErrScan, e)
+		}
+		records = append(records, r)
+	}
+	if e := rows.Err(); e != nil {
+		return nil, queryError(ctx, e)
+	}
+
+	return records, nil
+}
+
+func (self *SQLiteStore) Composite(ctx context.Context, num int) (CompositeRecord, error) {
+	record := CompositeRecord{Num: num}
+
+	if e := self.prepareComposites(ctx); e != nil {
+		return record, e
+	}
+
+	rows, e := self.composite.QueryContext(ctx, num)
+	if e != nil {
+		return record, queryError(ctx, fmt.Errorf("composite %d: %w", num, e))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var v Verse
+		if e := rows.Scan(&v.Book, &v.Chapter, &v.Verse, &v.Content); e != nil {
+			return record, fmt.Errorf("%w: %w", ErrScan, e)
+		}
+		v.Book = compositeBook(v.Book)
+		record.Verses = append(record.Verses, v)
+	}
+	if e := rows.Err(); e != nil {
+		return record, queryError(ctx, e)
+	}
+
+	if self.compositeReferences == nil {
+		return record, nil
+	}
+
+	refs, e := self.compositeReferences.QueryContext(ctx, num)
+	if e != nil {
+		return record, queryError(ctx, fmt.Errorf("composite %d: %w", num, e))
+	}
+	defer refs.Close()
+
+	for refs.Next() {
+		var r CompositeReference
+		if e := refs.Scan(&r.Book, &r.StartChapter, &r.StartVerse, &r.EndChapter, &r.EndVerse); e != nil {
+			return record, fmt.Errorf("%w: %w", ErrScan, e)
+		}
+		r.Book = compositeBook(r.Book)
+		record.References = append(record.References, r)
+	}
+	if e := refs.Err(); e != nil {
+		return record, queryError(ctx, e)
+	}
+
+	return record, nil
+}
+
+// Encode the dates the way the queries decode them: month * 100 + day.
+func dateKeys(dates []MonthDay) []int {
+	keys := make([]int, 0, len(dates))
+	for _, date := range dates {
+		keys = append(keys, date.Month*100+date.Day)
+	}
+
+	return keys
+}
+
+// Encode a slice as a JSON array for use with json_each.
+func jsonArray(values interface{}) string {
+	// Marshaling slices of ints and strings cannot fail.
+	data, _ := json.Marshal(values)
+	if string(data) == "null" {
+		return "[]"
+	}
+
+	return string(data)
+}
+
+// Wrap a database error. Cancellation and deadline errors are returned as is
+// so that callers can compare them with the context package's errors.
+func queryError(ctx context.Context, e error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return fmt.Errorf("%w: %w", ErrQuery, e)
+}
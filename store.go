@@ -0,0 +1,125 @@
+package orthocal
+
+import (
+	"context"
+)
+
+// A CalendarStore provides the calendar data that DayFactory uses to build
+// Days. The records mirror the tables in the sql directory. Records are
+// returned in the order in which they appear in the data set since the order
+// of titles, saints and readings on a Day depends on it.
+type CalendarStore interface {
+	// Commemorations returns the records whose pdist is one of pdists or
+	// whose month and day are one of dates.
+	Commemorations(ctx context.Context, pdists []int, dates []MonthDay) ([]CommemorationRecord, error)
+
+	// Readings returns the records whose pdist is one of pdists or whose
+	// month and day are one of dates.
+	Readings(ctx context.Context, pdists []int, dates []MonthDay) ([]ReadingRecord, error)
+
+	// Pericopes returns the records for the given pericopes. Pericopes that
+	// do not exist are omitted.
+	Pericopes(ctx context.Context, keys []PericopeKey) ([]PericopeRecord, error)
+
+	// Composite returns the given composite reading. The record has no
+	// references or verses if it does not exist.
+	Composite(ctx context.Context, num int) (CompositeRecord, error)
+}
+
+// A MonthDay is a fixed date on the calendar.
+type MonthDay struct {
+	Month int
+	Day   int
+}
+
+// A CommemorationRecord is a row of the days table. Records for movable
+// commemorations have a pdist and a zero month and day. Records for fixed
+// commemorations have a month and day and a pdist of 999.
+type CommemorationRecord struct {
+	PDist         int
+	Month         int
+	Day           int
+	Title         string
+	Subtitle      string
+	FeastName     string
+	FeastLevel    int
+	ServiceNote   string
+	Saint         string
+	Fast          int
+	FastException int
+}
+
+// A ReadingRecord is a row of the readings table. Records for movable
+// readings have a pdist and a zero month and day. Records for fixed readings
+// have a month and day and a pdist of 999.
+type ReadingRecord struct {
+	PDist       int
+	Month       int
+	Day         int
+	Source      string
+	Description string
+	Book        string
+	Pericope    string
+	Ordering    int
+}
+
+// A PericopeKey identifies a pericope within a lectionary book.
+type PericopeKey struct {
+	Book     string
+	Pericope string
+}
+
+// A PericopeRecord is a row of the pericopes table.
+type PericopeRecord struct {
+	PericopeKey
+	Display      string
+	ShortDisplay string
+	Description  string
+	Preverse     string
+	Prefix       string
+	PrefixB      string
+	Verses       string
+	Suffix       string
+}
+
+// A CompositeRecord is a reading made up of several passages. Verses holds the
+// fixed translation of the reading from the composites table, if there is
+// one, in the order in which the verses are read.
+type CompositeRecord struct {
+	Num        int
+	References []CompositeReference
+	Verses     []Verse
+}
+
+// A CompositeReference is a row of the composite_references table. A verse of
+// 0 means that only the chapter is known. Book is the USFM code of the book,
+// as in the verses of a Passage.
+type CompositeReference struct {
+	Book         string
+	StartChapter int
+	StartVerse   int
+	EndChapter   int
+	EndVerse     int
+}
+
+// Format the reference the way pericopes are displayed, e.g. Isa 63.15-64.5.
+func (self CompositeReference) String() string {
+	return Reference{
+		Book:         self.Book,
+		StartChapter: self.StartChapter,
+		StartVerse:   self.StartVerse,
+		EndChapter:   self.EndChapter,
+		EndVerse:     self.EndVerse,
+	}.String()
+}
+
+// The composites tables name books as the lectionary does, e.g. Zech. They are
+// given by their USFM codes like the books of other passages. A name that is
+// not known is kept as it is.
+func compositeBook(name string) string {
+	if book := NormalizeBookName(name); len(book) > 0 {
+		return book
+	}
+
+	return name
+}
@@ -0,0 +1,102 @@
+package orthocal
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Today
+//
+// Which day it is depends on where one is, and the liturgical day begins
+// with vespers on the evening before the civil day. ResolveDate finds the
+// civil date of a time in a location and the date of the liturgical day that
+// has begun by then.
+
+// A DayBoundary returns the time at which the next liturgical day begins on
+// a civil date, which is given as midnight in the location. A nil DayBoundary
+// begins the liturgical day at midnight.
+type DayBoundary func(date time.Time) time.Time
+
+// FixedBoundary returns a DayBoundary that begins the liturgical day at the
+// same time every evening, e.g. 18:00.
+func FixedBoundary(hour, minute int) DayBoundary {
+	return func(date time.Time) time.Time {
+		year, month, day := date.Date()
+		return time.Date(year, month, day, hour, minute, 0, 0, date.Location())
+	}
+}
+
+// SunsetBoundary returns a DayBoundary that begins the liturgical day at
+// sunset at the given latitude and longitude in degrees, with north and east
+// positive. Where the sun does not set or rise, the day begins at midnight.
+func SunsetBoundary(latitude, longitude float64) DayBoundary {
+	return func(date time.Time) time.Time {
+		year, month, day := date.Date()
+		sunset, ok := computeSunset(GregorianDateToJDN(year, int(month), day), latitude, longitude)
+		if !ok {
+			return time.Date(year, month, day+1, 0, 0, 0, 0, date.Location())
+		}
+		return sunset.In(date.Location())
+	}
+}
+
+// Compute the time of sunset on the day with the given Julian day number
+// with the sunrise equation. The time is accurate to within a few minutes.
+// False is returned if the sun does not set or rise that day.
+func computeSunset(jdn int, latitude, longitude float64) (time.Time, bool) {
+	const j2000 = 2451545.0
+	radians := math.Pi / 180
+
+	// The mean solar time of noon in days since 1/1/2000
+	noon := float64(jdn) - j2000 - longitude/360
+
+	anomaly := math.Mod(357.5291+0.98560028*noon, 360) * radians
+	center := 1.9148*math.Sin(anomaly) + 0.0200*math.Sin(2*anomaly) + 0.0003*math.Sin(3*anomaly)
+	ecliptic := math.Mod(anomaly/radians+center+180+102.9372, 360) * radians
+	transit := j2000 + noon + 0.0053*math.Sin(anomaly) - 0.0069*math.Sin(2*ecliptic)
+
+	declination := math.Asin(math.Sin(ecliptic) * math.Sin(23.4397*radians))
+
+	// The sun sets when its upper edge is refracted below the horizon
+	cosHourAngle := (math.Sin(-0.833*radians) - math.Sin(latitude*radians)*math.Sin(declination)) /
+		(math.Cos(latitude*radians) * math.Cos(declination))
+	if cosHourAngle < -1 || cosHourAngle > 1 {
+		return time.Time{}, false
+	}
+
+	sunset := transit + math.Acos(cosHourAngle)/radians/360
+
+	// The Julian date of the Unix epoch is 2440587.5
+	seconds := (sunset - 2440587.5) * 86400
+	return time.Unix(0, int64(seconds*float64(time.Second))).UTC(), true
+}
+
+// ResolveDate returns the civil date of t in the location and the date of the
+// liturgical day at t, which is the next day once the boundary has passed.
+// Both are midnight in the location. The location of t is used if location
+// is nil.
+func ResolveDate(t time.Time, location *time.Location, boundary DayBoundary) (civil, liturgical time.Time) {
+	if location == nil {
+		location = t.Location()
+	}
+
+	local := t.In(location)
+	year, month, day := local.Date()
+	civil = time.Date(year, month, day, 0, 0, 0, 0, location)
+
+	liturgical = civil
+	if boundary != nil && !local.Before(boundary(civil)) {
+		liturgical = time.Date(year, month, day+1, 0, 0, 0, 0, location)
+	}
+
+	return civil, liturgical
+}
+
+// NewDayAt builds the Day of the liturgical day at t in the location. See
+// ResolveDate.
+func (self *DayFactory) NewDayAt(ctx context.Context, t time.Time, location *time.Location, boundary DayBoundary, bible Bible) (*Day, error) {
+	_, liturgical := ResolveDate(t, location, boundary)
+	year, month, day := liturgical.Date()
+	return self.NewDayWithContext(ctx, year, int(month), day, bible)
+}
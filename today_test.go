@@ -0,0 +1,103 @@
+package orthocal_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"testing"
+	"time"
+)
+
+func TestResolveDate(t *testing.T) {
+	california, e := time.LoadLocation("America/Los_Angeles")
+	if e != nil {
+		t.Fatalf("Got error loading location: %#v.", e)
+	}
+
+	// 7:00 PM on 2/17/2018 in California
+	evening := time.Date(2018, 2, 18, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		t          time.Time
+		location   *time.Location
+		boundary   orthocal.DayBoundary
+		civil      int
+		liturgical int
+	}{
+		{"UTC", evening, time.UTC, nil, 18, 18},
+		{"Nil Location", evening.In(california), nil, nil, 17, 17},
+		{"Midnight", evening, california, nil, 17, 17},
+		{"Before Vespers", evening, california, orthocal.FixedBoundary(20, 0), 17, 17},
+		{"After Vespers", evening, california, orthocal.FixedBoundary(18, 0), 17, 18},
+		{"After Sunset", evening, california, orthocal.SunsetBoundary(34.05, -118.24), 17, 18},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			civil, liturgical := orthocal.ResolveDate(test.t, test.location, test.boundary)
+			if civil.Day() != test.civil || liturgical.Day() != test.liturgical {
+				t.Errorf("Expected 2/%d and 2/%d but got %s and %s.", test.civil, test.liturgical, civil.Format("1/2"), liturgical.Format("1/2"))
+			}
+			if civil.Hour() != 0 || liturgical.Hour() != 0 {
+				t.Errorf("The dates should be midnight but are %s and %s.", civil, liturgical)
+			}
+		})
+	}
+
+	t.Run("Sunset", func(t *testing.T) {
+		tests := []struct {
+			month, day     int
+			hour, minute   int
+			latitude       float64
+			longitude      float64
+			location       *time.Location
+			description    string
+			expectedSunset bool
+		}{
+			{6, 21, 20, 8, 34.05, -118.24, california, "Los Angeles in summer", true},
+			{12, 21, 16, 47, 34.05, -118.24, california, "Los Angeles in winter", true},
+			{6, 21, 0, 0, 69.65, 18.96, time.UTC, "Tromsø at midsummer", false},
+		}
+
+		for _, test := range tests {
+			date := time.Date(2018, time.Month(test.month), test.day, 0, 0, 0, 0, test.location)
+			sunset := orthocal.SunsetBoundary(test.latitude, test.longitude)(date)
+
+			if !test.expectedSunset {
+				if !sunset.Equal(date.AddDate(0, 0, 1)) {
+					t.Errorf("%s should have no sunset but got %s.", test.description, sunset)
+				}
+				continue
+			}
+
+			expected := time.Date(2018, time.Month(test.month), test.day, test.hour, test.minute, 0, 0, test.location)
+			if difference := sunset.Sub(expected); difference < -5*time.Minute || difference > 5*time.Minute {
+				t.Errorf("Sunset in %s should be about %s but is %s.", test.description, expected, sunset)
+			}
+		}
+	})
+
+	t.Run("Day", func(t *testing.T) {
+		db, e := sql.Open("sqlite3", "oca_calendar.db")
+		if e != nil {
+			t.Fatalf("Got error opening database: %#v.", e)
+		}
+		factory := orthocal.NewDayFactory(false, true, db)
+
+		// A server in UTC is already on Forgiveness Sunday
+		day, e := factory.NewDayAt(context.Background(), evening, california, nil, nil)
+		if e != nil {
+			t.Fatalf("Got error building day: %#v.", e)
+		}
+		if day.Day != 17 {
+			t.Errorf("It should still be 2/17/2018 in California but is %d/%d/%d.", day.Month, day.Day, day.Year)
+		}
+
+		day, _ = factory.NewDayAt(context.Background(), evening, california, orthocal.FixedBoundary(18, 0), nil)
+		if day.Day != 18 || day.Feasts[0] != "Forgiveness Sunday" {
+			t.Errorf("Vespers of Forgiveness Sunday should have begun but the day is %d/%d/%d.", day.Month, day.Day, day.Year)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package orthocal
+
+import (
+	"context"
+)
+
+// Translations
+//
+// No one translation has all of the readings. English Bibles that follow the
+// Masoretic text leave out most of the deuterocanonical paremias, for
+// instance, while a Septuagint may have no New Testament. Translations
+// combines several Bibles so that each reading comes from the first
+// translation that has it.
+
+// The translation of the passages of composite readings that have a fixed
+// translation
+const CompositeTranslation = "composite"
+
+// A Translation is a Bible that is used for the readings from some books.
+type Translation struct {
+	Name  string
+	Bible Bible
+
+	// The USFM codes of the books that are read from the translation, e.g.
+	// MAT. A translation without books is used for every book.
+	Books []string
+}
+
+// Translations is a Bible that looks up each reference in the first
+// translation that is used for its books and has the passage. References are
+// converted to the versification of each translation.
+type Translations []Translation
+
+// Lookup is like LookupWithContext with a background context.
+func (self Translations) Lookup(reference string) Passage {
+	return self.LookupWithContext(context.Background(), reference)
+}
+
+// LookupWithContext returns the passage of a reference from the first
+// translation that has it.
+func (self Translations) LookupWithContext(ctx context.Context, reference string) Passage {
+	passage, _ := self.lookup(ctx, reference, "")
+	return passage
+}
+
+// Look up a reference in the style of the short display, with the verses of
+// its pericope if there are any, and return the passage along with the name
+// of the translation that supplied it.
+func (self Translations) lookup(ctx context.Context, reference, verses string) (Passage, string) {
+	var books []string
+	if references, e := ParseReference(reference); e == nil {
+		for _, r := range references {
+			books = append(books, r.Book)
+		}
+	}
+
+	for _, translation := range self {
+		if !translation.hasBooks(books) {
+			continue
+		}
+
+		passage := translation.Bible.LookupWithContext(ctx, bibleReference(translation.Bible, reference, verses))
+		if ctx.Err() != nil {
+			return nil, ""
+		}
+		if len(passage) > 0 {
+			return passage, translation.Name
+		}
+	}
+
+	return nil, ""
+}
+
+// Returns whether the translation is used for all of the books. A translation
+// without books is used for every reference, even ones that cannot be
+// parsed.
+func (self Translation) hasBooks(books []string) bool {
+	if len(self.Books) == 0 {
+		return true
+	}
+	if len(books) == 0 {
+		return false
+	}
+
+	for _, book := range books {
+		found := false
+		for _, b := range self.Books {
+			if b == book {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Look up a reference in a bible, with the verses of its pericope if there are
+// any, and return the passage along with the name of the translation that
+// supplied it, which is empty unless the bible is Translations.
+func lookupPassage(ctx context.Context, bible Bible, reference, verses string) (Passage, string) {
+	if translations, ok := bible.(Translations); ok {
+		return translations.lookup(ctx, reference, verses)
+	}
+
+	return bible.LookupWithContext(ctx, bibleReference(bible, reference, verses)), ""
+}
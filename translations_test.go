@@ -0,0 +1,115 @@
+package orthocal_test
+
+import (
+	"database/sql"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTranslations(t *testing.T) {
+	kjv, e := orthocal.LoadBibleFiles(orthocal.VersificationMT, "testdata/kjv")
+	if e != nil {
+		t.Fatalf("Got error loading bible: %#v.", e)
+	}
+
+	var oldTestament []string
+	for _, book := range orthocal.Books() {
+		if book.Testament == orthocal.OldTestament {
+			oldTestament = append(oldTestament, book.Code)
+		}
+	}
+
+	t.Run("Books", func(t *testing.T) {
+		lxx := &mtBible{}
+		bible := orthocal.Translations{
+			{Name: "LXX", Bible: lxx, Books: oldTestament},
+			{Name: "KJV", Bible: kjv},
+		}
+
+		if passage := bible.Lookup("Matt 6.14-21"); len(passage) != 8 {
+			t.Errorf("Matt 6.14-21 should be 8 verses long but is %d.", len(passage))
+		}
+		if passage := bible.Lookup("Gen 1.1-13"); len(passage) != 1 || passage[0].Content != "Gen 1.1-13" {
+			t.Errorf("Gen 1.1-13 should be looked up in the Old Testament translation but got %#v.", passage)
+		}
+		if !reflect.DeepEqual(lxx.references, []string{"Gen 1.1-13"}) {
+			t.Errorf("Only Gen 1.1-13 should be looked up in the Old Testament translation but got %#v.", lxx.references)
+		}
+	})
+
+	t.Run("Fallback", func(t *testing.T) {
+		other := &mtBible{}
+		bible := orthocal.Translations{
+			{Name: "KJV", Bible: kjv},
+			{Name: "Other", Bible: other},
+		}
+
+		if passage := bible.Lookup("Luke 24.1-12"); len(passage) != 12 {
+			t.Errorf("Luke 24.1-12 should be 12 verses long but is %d.", len(passage))
+		}
+		if len(other.references) != 0 {
+			t.Errorf("Luke 24.1-12 should not fall back but got %#v.", other.references)
+		}
+
+		// Each translation gets the reference in its own versification
+		bible.Lookup("Ps 50")
+		if !reflect.DeepEqual(other.references, []string{"Ps 51"}) {
+			t.Errorf("Ps 50 should fall back to Ps 51 but got %#v.", other.references)
+		}
+
+		if passage := (orthocal.Translations{{Name: "KJV", Bible: kjv}}).Lookup("Wis 3.1-9"); passage != nil {
+			t.Errorf("Wis 3.1-9 should not be found but got %#v.", passage)
+		}
+	})
+
+	t.Run("Readings", func(t *testing.T) {
+		db, e := sql.Open("sqlite3", "oca_calendar.db")
+		if e != nil {
+			t.Fatalf("Got error opening database: %#v.", e)
+		}
+
+		factory := orthocal.NewDayFactory(false, true, db)
+		bible := orthocal.Translations{
+			{Name: "LXX", Bible: &mtBible{}, Books: oldTestament},
+			{Name: "KJV", Bible: kjv},
+		}
+
+		// Cheesefare Sunday
+		day := factory.NewDay(2018, 2, 18, bible)
+		for _, r := range day.Readings {
+			if r.Translation != "KJV" {
+				t.Errorf("%s should be from KJV but is from %#v.", r.ShortDisplay, r.Translation)
+			}
+		}
+
+		// Wednesday of Cheesefare Week has a composite reading with a fixed
+		// translation and paremias, including one from Wisdom. The Gospel and
+		// Epistle are not in the test translations.
+		day = factory.NewDay(2019, 2, 27, bible)
+		found := false
+		for _, r := range day.Readings {
+			expected := ""
+			if strings.HasPrefix(r.Display, "Composite") {
+				found = true
+				expected = orthocal.CompositeTranslation
+			} else if len(r.Passage) > 0 {
+				expected = "LXX"
+			}
+			if r.Translation != expected {
+				t.Errorf("%s should be from %#v but is from %#v.", r.ShortDisplay, expected, r.Translation)
+			}
+		}
+		if !found {
+			t.Errorf("2/27/2019 should have a composite reading but doesn't.")
+		}
+
+		// Without Translations there is no name
+		day = factory.NewDay(2018, 2, 18, kjv)
+		if day.Readings[0].Translation != "" {
+			t.Errorf("%s should not have a translation but has %#v.", day.Readings[0].ShortDisplay, day.Readings[0].Translation)
+		}
+	})
+}
@@ -0,0 +1,350 @@
+package orthocal
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Versification
+//
+// The lectionary follows the Septuagint in numbering the Psalms and in
+// placing the Song of the Three, Susanna and Bel within Daniel. Most English
+// Bibles follow the Masoretic text instead and put those passages in separate
+// books, as they do the Letter of Jeremiah, which they number as Baruch 6,
+// and the additions to Esther, which they number from Esther 10.4 on as in
+// the Vulgate. The Septuagint orders the second half of Jeremiah differently,
+// but the lectionary follows the Masoretic text there, so Jeremiah is
+// converted for Bibles that follow the Septuagint instead.
+//
+// The short display of a reading sometimes leaves out verses that are read,
+// as "Daniel 3.1-23" does the Song of the Three, so readings are converted
+// from the verses of their pericope where there are any.
+
+// A Versification is a way of dividing the books of the Bible into chapters
+// and verses.
+type Versification string
+
+const (
+	VersificationLXX Versification = "lxx" // the Septuagint, as in the lectionary but for Jeremiah
+	VersificationMT  Versification = "mt"  // the Masoretic text, as in most English Bibles
+)
+
+// A VersifiedBible is a Bible that does not follow the versification of the
+// lectionary. References are converted before they are looked up. Bibles
+// that do not implement VersifiedBible are assumed to follow the lectionary.
+type VersifiedBible interface {
+	Bible
+	Versification() Versification
+}
+
+// Stands in for the last verse of a chapter when it is not known
+const endOfChapter = 999
+
+// A versificationRule maps verses of a Septuagint chapter to the Masoretic
+// text. An end of 0 is the rest of the chapter, which must map to the whole
+// of the other chapter.
+type versificationRule struct {
+	book       string
+	chapter    int
+	start, end int
+	toBook     string
+	toChapter  int
+	offset     int // added to the verse
+}
+
+// A chapterShift is a range of chapters of the Septuagint that are numbered
+// shift more in the Masoretic text.
+type chapterShift struct {
+	book        string
+	first, last int
+	shift       int
+}
+
+var shiftedChapters = []chapterShift{
+	{"PSA", 10, 112, 1},
+	{"PSA", 116, 145, 1},
+	{"JER", 33, 50, -7},
+}
+
+var lxxToMT = []versificationRule{
+	{"PSA", 9, 1, 21, "PSA", 9, 0},
+	{"PSA", 9, 22, 0, "PSA", 10, -21},
+	{"PSA", 113, 1, 8, "PSA", 114, 0},
+	{"PSA", 113, 9, 0, "PSA", 115, -8},
+	{"PSA", 114, 1, 9, "PSA", 116, 0},
+	{"PSA", 115, 1, 10, "PSA", 116, 9},
+	{"PSA", 146, 1, 11, "PSA", 147, 0},
+	{"PSA", 147, 1, 9, "PSA", 147, 11},
+	{"DAN", 3, 1, 23, "DAN", 3, 0},
+	{"DAN", 3, 24, 90, "S3Y", 1, -23},
+	{"DAN", 3, 91, 97, "DAN", 3, -67},
+	{"DAN", 3, 98, 100, "DAN", 4, -97},
+	{"DAN", 4, 1, 34, "DAN", 4, 3},
+	{"DAN", 13, 1, 0, "SUS", 1, 0},
+	{"DAN", 14, 1, 0, "BEL", 1, 0},
+	{"LJE", 1, 1, 0, "BAR", 6, 0},
+	{"EST", 10, 1, 3, "EST", 10, 0},
+	{"EST", 10, 4, 0, "ESG", 10, 0},
+	{"EST", 11, 1, 0, "ESG", 11, 0},
+	{"EST", 12, 1, 0, "ESG", 12, 0},
+	{"EST", 13, 1, 0, "ESG", 13, 0},
+	{"EST", 14, 1, 0, "ESG", 14, 0},
+	{"EST", 15, 1, 0, "ESG", 15, 0},
+	{"EST", 16, 1, 0, "ESG", 16, 0},
+	{"JER", 25, 1, 13, "JER", 25, 0},
+	{"JER", 25, 14, 19, "JER", 49, 20},
+	{"JER", 26, 1, 0, "JER", 46, 0},
+	{"JER", 27, 1, 0, "JER", 50, 0},
+	{"JER", 28, 1, 0, "JER", 51, 0},
+	{"JER", 29, 1, 7, "JER", 47, 0},
+	{"JER", 29, 8, 23, "JER", 49, -1},
+	{"JER", 30, 1, 5, "JER", 49, 0},
+	{"JER", 30, 6, 11, "JER", 49, 22},
+	{"JER", 30, 12, 16, "JER", 49, 11},
+	{"JER", 31, 1, 0, "JER", 48, 0},
+	{"JER", 32, 15, 0, "JER", 25, 0},
+	{"JER", 51, 1, 30, "JER", 44, 0},
+	{"JER", 51, 31, 0, "JER", 45, -30},
+}
+
+// The lectionary numbers these books as the Masoretic text does
+var lectionaryMT = map[string]bool{"JER": true}
+
+// The Masoretic rules are the Septuagint rules turned around
+var mtToLXX = invertRules(lxxToMT)
+
+func invertRules(rules []versificationRule) []versificationRule {
+	inverted := make([]versificationRule, len(rules))
+	for i, r := range rules {
+		inverted[i] = versificationRule{r.toBook, r.toChapter, r.start + r.offset, 0, r.book, r.chapter, -r.offset}
+		if r.end != 0 {
+			inverted[i].end = r.end + r.offset
+		}
+	}
+
+	return inverted
+}
+
+// A piece of a chapter. A to of endOfChapter is the rest of the chapter.
+type versePiece struct {
+	book     string
+	chapter  int
+	from, to int
+}
+
+// ConvertReference converts a reference from one versification to another.
+// A range is split where the parts of it are numbered differently.
+func ConvertReference(r Reference, from, to Versification) []Reference {
+	if from == to {
+		return []Reference{r}
+	}
+
+	rules, shift := lxxToMT, 1
+	if from == VersificationMT {
+		rules, shift = mtToLXX, -1
+	}
+
+	var pieces []versePiece
+	for _, piece := range r.chapterPieces() {
+		pieces = append(pieces, convertPiece(piece, rules, shift)...)
+	}
+
+	return joinPieces(pieces, r)
+}
+
+// Split the reference into a piece for each chapter.
+func (self Reference) chapterPieces() []versePiece {
+	var pieces []versePiece
+
+	for chapter := self.StartChapter; chapter <= self.EndChapter; chapter++ {
+		piece := versePiece{self.Book, chapter, 1, endOfChapter}
+		if chapter == self.StartChapter && self.StartVerse != 0 {
+			piece.from = self.StartVerse
+		}
+		if chapter == self.EndChapter && self.EndVerse != 0 {
+			piece.to = self.EndVerse
+		}
+		pieces = append(pieces, piece)
+	}
+
+	return pieces
+}
+
+func convertPiece(piece versePiece, rules []versificationRule, shift int) []versePiece {
+	var converted []versePiece
+	ruled := false
+
+	for _, rule := range rules {
+		if rule.book != piece.book || rule.chapter != piece.chapter {
+			continue
+		}
+		ruled = true
+
+		end := rule.end
+		if end == 0 {
+			end = endOfChapter
+		}
+
+		from, to := piece.from, piece.to
+		if rule.start > from {
+			from = rule.start
+		}
+		if end < to {
+			to = end
+		}
+		if from > to {
+			continue
+		}
+
+		c := versePiece{rule.toBook, rule.toChapter, from + rule.offset, to + rule.offset}
+		if to == endOfChapter {
+			c.to = endOfChapter
+		}
+		converted = append(converted, c)
+	}
+
+	if ruled {
+		return converted
+	}
+
+	for _, chapters := range shiftedChapters {
+		if chapters.book != piece.book {
+			continue
+		}
+
+		first, last := chapters.first, chapters.last
+		if shift < 0 {
+			first, last = first+chapters.shift, last+chapters.shift
+		}
+		if first <= piece.chapter && piece.chapter <= last {
+			piece.chapter += shift * chapters.shift
+			break
+		}
+	}
+
+	return []versePiece{piece}
+}
+
+// Join pieces that continue one another into references.
+func joinPieces(pieces []versePiece, original Reference) []Reference {
+	var references []Reference
+
+	for i, piece := range pieces {
+		r := Reference{Book: piece.book, StartChapter: piece.chapter, StartVerse: piece.from, EndChapter: piece.chapter, EndVerse: piece.to}
+		if i == 0 {
+			r.StartPart, r.Separator, r.BookName = original.StartPart, original.Separator, original.BookName
+		}
+		if i == len(pieces)-1 {
+			r.EndPart, r.Remark = original.EndPart, original.Remark
+		}
+
+		if n := len(references); n > 0 {
+			last := &references[n-1]
+			continues := last.Book == r.Book &&
+				((last.EndVerse == endOfChapter && r.StartChapter == last.EndChapter+1 && r.StartVerse == 1) ||
+					(last.EndChapter == r.StartChapter && last.EndVerse+1 == r.StartVerse))
+			if continues {
+				last.EndChapter, last.EndVerse, last.EndPart, last.Remark = r.EndChapter, r.EndVerse, r.EndPart, r.Remark
+				continue
+			}
+		}
+
+		references = append(references, r)
+	}
+
+	// Whole chapters are given without verses
+	for i := range references {
+		r := &references[i]
+		if r.StartVerse == 1 && r.EndVerse == endOfChapter && len(r.StartPart) == 0 {
+			r.StartVerse, r.EndVerse = 0, 0
+		}
+	}
+
+	return references
+}
+
+// Convert a reference in the style of the short display for the bible. The
+// verses are those of the pericope, which are converted instead if they
+// differ in the versification of the bible. The reference is returned as it
+// is if nothing differs or it cannot be parsed.
+func bibleReference(bible Bible, reference, verses string) string {
+	versified, ok := bible.(VersifiedBible)
+	if !ok {
+		return reference
+	}
+
+	if references, e := parsePericopeVerses(verses); e == nil && len(verses) > 0 {
+		if converted, changed := convertLectionary(references, versified.Versification()); changed {
+			return FormatShortDisplay(converted)
+		}
+	}
+
+	references, e := ParseReference(reference)
+	if e != nil {
+		return reference
+	}
+
+	converted, changed := convertLectionary(references, versified.Versification())
+	if !changed {
+		return reference
+	}
+
+	return FormatShortDisplay(converted)
+}
+
+// Convert references of the lectionary to another versification and return
+// whether any of them changed.
+func convertLectionary(references []Reference, to Versification) ([]Reference, bool) {
+	var converted []Reference
+	changed := false
+
+	for _, r := range references {
+		from := VersificationLXX
+		if lectionaryMT[r.Book] {
+			from = VersificationMT
+		}
+
+		c := ConvertReference(r, from, to)
+		if len(c) != 1 || c[0] != r {
+			changed = true
+		}
+		converted = append(converted, c...)
+	}
+
+	return converted, changed
+}
+
+// Separates the number of a book from its name, e.g. 1Peter
+var versesBookRe = regexp.MustCompile(`^\s*(\d)(\D)`)
+
+// Parse the verses column of a pericope, e.g. Dan_3001_3088|Dan_3091_3097,
+// in which each verse is numbered as chapter*1000+verse.
+func parsePericopeVerses(verses string) ([]Reference, error) {
+	var references []Reference
+
+	for _, part := range strings.Split(verses, "|") {
+		fields := strings.Split(strings.TrimSpace(part), "_")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%w: cannot parse verses %#v", ErrInvalidReference, verses)
+		}
+
+		book := NormalizeBookName(versesBookRe.ReplaceAllString(fields[0], "$1 $2"))
+		start, e1 := strconv.Atoi(fields[1])
+		end, e2 := strconv.Atoi(fields[2])
+		if len(book) == 0 || e1 != nil || e2 != nil {
+			return nil, fmt.Errorf("%w: cannot parse verses %#v", ErrInvalidReference, verses)
+		}
+
+		references = append(references, Reference{
+			Book:         book,
+			StartChapter: start / 1000,
+			StartVerse:   start % 1000,
+			EndChapter:   end / 1000,
+			EndVerse:     end % 1000,
+		})
+	}
+
+	return references, nil
+}
@@ -0,0 +1,166 @@
+package orthocal_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/brianglass/orthocal"
+	_ "github.com/mattn/go-sqlite3"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mtBible records the references it is asked for.
+type mtBible struct {
+	sync.Mutex
+	references []string
+}
+
+func (self *mtBible) Lookup(reference string) orthocal.Passage {
+	return self.LookupWithContext(context.Background(), reference)
+}
+
+func (self *mtBible) LookupWithContext(ctx context.Context, reference string) orthocal.Passage {
+	self.Lock()
+	defer self.Unlock()
+
+	self.references = append(self.references, reference)
+	return orthocal.Passage{{Content: reference}}
+}
+
+func (self *mtBible) Versification() orthocal.Versification {
+	return orthocal.VersificationMT
+}
+
+// lxxBible is an mtBible that follows the Septuagint.
+type lxxBible struct {
+	mtBible
+}
+
+func (self *lxxBible) Versification() orthocal.Versification {
+	return orthocal.VersificationLXX
+}
+
+func TestConvertReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		expected  []string
+	}{
+		{"Ps 50", []string{"Ps 51"}},
+		{"Ps 50.1-5", []string{"Ps 51.1-5"}},
+		{"Ps 9", []string{"Ps 9.1-21", "Ps 10"}},
+		{"Ps 9.20-10.2", []string{"Ps 9.20-21", "Ps 10.1-11.2"}},
+		{"Ps 113.1-26", []string{"Ps 114.1-8", "Ps 115.1-18"}},
+		{"Ps 115.1-10", []string{"Ps 116.10-19"}},
+		{"Ps 148", []string{"Ps 148"}},
+		{"Dan 3.1-23", []string{"Daniel 3.1-23"}},
+		{"Dan 3.88-93", []string{"Song of the Three 65-67", "Daniel 3.24-26"}},
+		{"Dan 13.1-5", []string{"Susanna 1-5"}},
+		{"Esth 10.1-6", []string{"Esth 10.1-3", "Additions to Esther 10.4-6"}},
+		{"Esth 13.8-18", []string{"Additions to Esther 13.8-18"}},
+		{"Jer 38.31-34", []string{"Jer 31.31-34"}},
+		{"Jer 30.1-8", []string{"Jer 49.1-5", "Jer 49.28-30"}},
+		{"Matt 5.1-12", []string{"Matt 5.1-12"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.reference, func(t *testing.T) {
+			references, e := orthocal.ParseReference(test.reference)
+			if e != nil {
+				t.Fatalf("Got error parsing %#v: %#v.", test.reference, e)
+			}
+
+			var actual []string
+			for _, r := range orthocal.ConvertReference(references[0], orthocal.VersificationLXX, orthocal.VersificationMT) {
+				actual = append(actual, r.String())
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("%s should be %#v in the Masoretic text but got %#v.", test.reference, test.expected, actual)
+			}
+		})
+	}
+
+	t.Run("Masoretic", func(t *testing.T) {
+		references, _ := orthocal.ParseReference("Ps 116")
+
+		var actual []string
+		for _, r := range orthocal.ConvertReference(references[0], orthocal.VersificationMT, orthocal.VersificationLXX) {
+			actual = append(actual, r.String())
+		}
+		if expected := []string{"Ps 114.1-9", "Ps 115.1-10"}; !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Ps 116 should be %#v in the Septuagint but got %#v.", expected, actual)
+		}
+	})
+}
+
+func TestVersifiedBible(t *testing.T) {
+	db, e := sql.Open("sqlite3", "oca_calendar.db")
+	if e != nil {
+		t.Errorf("Got error opening database: %#v.", e)
+	}
+
+	factory := orthocal.NewDayFactory(false, true, db)
+	factory.SetRawScripture(true)
+
+	// Holy Saturday
+	lookups := func(bible orthocal.Bible) map[string]string {
+		day := factory.NewDay(2018, 4, 7, bible)
+
+		references := make(map[string]string)
+		for _, r := range day.Readings {
+			if len(r.Passage) > 0 {
+				references[r.ShortDisplay] = r.Passage[0].Content
+			}
+		}
+		return references
+	}
+
+	t.Run("Masoretic", func(t *testing.T) {
+		references := lookups(&mtBible{})
+
+		// The verses of the pericope include the Song of the Three
+		if reference, expected := references["Daniel 3.1-23"], "Daniel 3.1-23; Song of the Three 1-65"; reference != expected {
+			t.Errorf("Daniel should be looked up in the Masoretic text as %#v but was looked up as %#v.", expected, reference)
+		}
+		if reference := references["Jer 31.31-34"]; reference != "Jer 31.31-34" {
+			t.Errorf("Jeremiah should be looked up as it is in the lectionary but was looked up as %#v.", reference)
+		}
+	})
+
+	t.Run("Septuagint", func(t *testing.T) {
+		references := lookups(&lxxBible{})
+
+		if reference := references["Daniel 3.1-23"]; reference != "Daniel 3.1-23" {
+			t.Errorf("Daniel should be looked up as it is in the lectionary but was looked up as %#v.", reference)
+		}
+		if reference := references["Jer 31.31-34"]; reference != "Jer 38.31-34" {
+			t.Errorf("Jeremiah should be looked up in the Septuagint as Jer 38.31-34 but was looked up as %#v.", reference)
+		}
+	})
+
+	t.Run("Year", func(t *testing.T) {
+		bible := &mtBible{}
+		days, e := factory.NewDaysInRange(context.Background(), time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2018, 12, 31, 0, 0, 0, 0, time.UTC), bible)
+		if e != nil {
+			t.Fatalf("Got error building 2018: %#v.", e)
+		}
+
+		converted := 0
+		for _, day := range days {
+			for _, r := range day.Readings {
+				if len(r.Passage) == 0 || r.Translation == orthocal.CompositeTranslation || r.Passage[0].Content == r.ShortDisplay {
+					continue
+				}
+				converted++
+
+				if _, e := orthocal.ParseReference(r.Passage[0].Content); e != nil {
+					t.Errorf("%s was looked up as %#v, which cannot be parsed.", r.ShortDisplay, r.Passage[0].Content)
+				}
+			}
+		}
+		if converted == 0 {
+			t.Errorf("Some readings of 2018 should be looked up differently in the Masoretic text but none were.")
+		}
+	})
+}
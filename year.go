@@ -34,6 +34,8 @@ type Year struct {
 	// unexported
 	floats    []float
 	noDaily   map[int]bool
+	transfers map[int]int // pdist of the day reading -> pdist of the suppressed day
+	reverse   map[int]int // pdist of the suppressed day -> pdist of the day reading
 	useJulian bool
 }
 
@@ -47,6 +49,8 @@ func NewYear(year int, useJulian bool) *Year {
 
 	self.floats = make([]float, 0, 38)
 	self.noDaily = make(map[int]bool)
+	self.transfers = make(map[int]int)
+	self.reverse = make(map[int]int)
 
 	self.useJulian = useJulian
 	self.Year = year
@@ -99,6 +103,29 @@ func (self *Year) HasNoDailyReadings(pdist int) bool {
 	return exists
 }
 
+// Returns the pdist of the day whose suppressed daily readings are read on
+// the given day or 499 if there is none. Only the transfer for Theophany on a
+// Sunday is handled.
+func (self *Year) LookupTransferredReadings(pdist int) int {
+	if from, exists := self.transfers[pdist]; exists {
+		return from
+	}
+
+	return 499
+}
+
+// Returns the pdist of the day on which the suppressed daily readings of the
+// given day are read or 499 if they are not read. Only the transfer for
+// Theophany on a Sunday is handled, so the readings of a day are transferred
+// only when it is the Eve of Theophany on a Saturday.
+func (self *Year) LookupReadingsTransfer(pdist int) int {
+	if to, exists := self.reverse[pdist]; exists {
+		return to
+	}
+
+	return 499
+}
+
 func (self *Year) DateToPDist(month, day, year int) int {
 	if self.useJulian {
 		// TODO: Need to test this and confirm it's valid
@@ -108,6 +135,19 @@ func (self *Year) DateToPDist(month, day, year int) int {
 	}
 }
 
+// Convert a distance from Pascha into a date on the calendar the year was
+// created with. This is the inverse of DateToPDist. Use JDNToGregorianDate or
+// JDNToJulianDate with Pascha+pdist to get the date on a specific calendar.
+func (self *Year) PDistToDate(pdist int) (month, day, year int) {
+	if self.useJulian {
+		year, month, day = JDNToJulianDate(self.Pascha + pdist)
+	} else {
+		year, month, day = JDNToGregorianDate(self.Pascha + pdist)
+	}
+
+	return month, day, year
+}
+
 // Compute the distance from Pascha for important feast days.
 func (self *Year) computePDists() {
 	var pdist, weekday int // for intermediate results
@@ -277,6 +317,14 @@ func (self *Year) computeNoDailyReadings() {
 		self.noDaily[self.Theophany+1] = true
 	}
 
+	// If the Saturday before Theophany is the Eve, the Royal Hours are served
+	// on Friday, which has no Liturgy, so the Saturday's daily readings are
+	// read on Thursday.
+	if WeekDayFromPDist(self.Theophany) == Sunday {
+		self.transfers[self.Theophany-3] = self.Theophany - 1
+		self.reverse[self.Theophany-1] = self.Theophany - 3
+	}
+
 	self.noDaily[self.Forefathers] = true
 
 	_, sunBefore, _, sunAfter = SurroundingWeekends(self.Nativity)
@@ -111,6 +111,29 @@ func TestNoDaily(t *testing.T) {
 	}
 }
 
+func TestTransferredReadings(t *testing.T) {
+	// Theophany 2019 is a Sunday, so the Eve is a Saturday
+	year := orthocal.NewYear(2018, false)
+
+	eve, thursday := year.Theophany-1, year.Theophany-3
+	if from := year.LookupTransferredReadings(thursday); from != eve {
+		t.Errorf("Thursday %d should have the readings of Saturday %d but has %d.", thursday, eve, from)
+	}
+	if to := year.LookupReadingsTransfer(eve); to != thursday {
+		t.Errorf("Saturday %d's readings should be read on Thursday %d but are read on %d.", eve, thursday, to)
+	}
+
+	// Theophany 2020 is a Monday and 2024 a Saturday
+	for _, y := range []int{2019, 2023} {
+		year = orthocal.NewYear(y, false)
+		for pdist := year.Theophany - 7; pdist <= year.Theophany; pdist++ {
+			if year.LookupTransferredReadings(pdist) != 499 || year.LookupReadingsTransfer(pdist) != 499 {
+				t.Errorf("Day %d of %d should not have transferred readings.", pdist, y)
+			}
+		}
+	}
+}
+
 func TestPeremias(t *testing.T) {
 	year := orthocal.NewYear(2018, false)
 
@@ -125,3 +148,30 @@ func TestPeremias(t *testing.T) {
 		t.Errorf("List of no-peremias is incorrect: %v.", year.NoParemias)
 	}
 }
+
+func TestPDistToDate(t *testing.T) {
+	year := orthocal.NewYear(2018, false)
+
+	month, day, y := year.PDistToDate(year.Nativity)
+	if month != 12 || day != 25 || y != 2018 {
+		t.Errorf("Got incorrect date for Nativity: %d/%d/%d. Should be 12/25/2018", month, day, y)
+	}
+
+	month, day, y = year.PDistToDate(year.Theophany)
+	if month != 1 || day != 6 || y != 2019 {
+		t.Errorf("Got incorrect date for Theophany: %d/%d/%d. Should be 1/6/2019", month, day, y)
+	}
+
+	for _, pdist := range year.Reserves {
+		month, day, y := year.PDistToDate(pdist)
+		if year.DateToPDist(month, day, y) != pdist {
+			t.Errorf("PDistToDate(%d) returned %d/%d/%d which does not convert back", pdist, month, day, y)
+		}
+	}
+
+	julian := orthocal.NewYear(2018, true)
+	month, day, y = julian.PDistToDate(0)
+	if month != 3 || day != 26 || y != 2018 {
+		t.Errorf("Got incorrect Julian date for Pascha: %d/%d/%d. Should be 3/26/2018", month, day, y)
+	}
+}